@@ -0,0 +1,3 @@
+data "virtfusion_hypervisor" "hv1" {
+  name = "hv1.example.com"
+}
@@ -0,0 +1,15 @@
+data "virtfusion_hypervisor_group" "london" {
+  name = "London"
+
+  lifecycle {
+    postcondition {
+      condition     = self.available_hypervisors > 0 && self.free_memory >= 4096
+      error_message = "The London location has no capacity left."
+    }
+  }
+}
+
+resource "virtfusion_server" "node1" {
+  user_id       = 1
+  hypervisor_id = data.virtfusion_hypervisor_group.london.id
+}
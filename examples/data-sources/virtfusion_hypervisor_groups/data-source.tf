@@ -0,0 +1,3 @@
+data "virtfusion_hypervisor_groups" "all" {
+  enabled_only = true
+}
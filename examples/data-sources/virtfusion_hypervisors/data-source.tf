@@ -0,0 +1,3 @@
+data "virtfusion_hypervisors" "london" {
+  hypervisor_group_id = data.virtfusion_hypervisor_group.london.id
+}
@@ -0,0 +1,9 @@
+data "virtfusion_ip_blocks" "public_v4" {
+  ip_version          = 4
+  type                = "public"
+  hypervisor_group_id = 14
+}
+
+output "free_public_ipv4" {
+  value = sum([for b in data.virtfusion_ip_blocks.public_v4.blocks : b.free])
+}
@@ -0,0 +1,6 @@
+data "virtfusion_os_template" "ubuntu" {
+  server_id    = virtfusion_server.node1.id
+  name         = "Ubuntu Server"
+  version      = "22.04"
+  architecture = "x86_64"
+}
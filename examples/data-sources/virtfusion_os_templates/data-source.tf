@@ -0,0 +1,4 @@
+data "virtfusion_os_templates" "debian" {
+  server_id  = virtfusion_server.node1.id
+  name_regex = "^Debian"
+}
@@ -0,0 +1,8 @@
+data "virtfusion_package" "small" {
+  name = "VPS Small"
+}
+
+resource "virtfusion_server" "node1" {
+  user_id    = 1
+  package_id = data.virtfusion_package.small.id
+}
@@ -0,0 +1,4 @@
+data "virtfusion_packages" "vps" {
+  name_regex   = "^VPS"
+  enabled_only = true
+}
@@ -0,0 +1,7 @@
+data "virtfusion_server" "web" {
+  name = "web-01"
+}
+
+output "web_ip" {
+  value = data.virtfusion_server.web.primary_ipv4
+}
@@ -0,0 +1,8 @@
+data "virtfusion_server_backups" "node1" {
+  server_id = virtfusion_server.node1.id
+  status    = "complete"
+}
+
+output "latest_backup_id" {
+  value = try(data.virtfusion_server_backups.node1.backups[length(data.virtfusion_server_backups.node1.backups) - 1].id, null)
+}
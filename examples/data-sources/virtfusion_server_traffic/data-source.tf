@@ -0,0 +1,14 @@
+data "virtfusion_server_traffic" "node1" {
+  server_id       = virtfusion_server.node1.id
+  include_history = true
+}
+
+output "node1_traffic_gb_this_period" {
+  value = data.virtfusion_server_traffic.node1.total_bytes / 1e9
+}
+
+output "node1_traffic_gb_by_month" {
+  value = {
+    for p in data.virtfusion_server_traffic.node1.history : p.period_start => p.total_bytes / 1e9
+  }
+}
@@ -0,0 +1,11 @@
+data "virtfusion_servers" "web" {
+  user_id     = 1
+  name_regex  = "^web-"
+  power_state = "running"
+}
+
+output "ansible_inventory" {
+  value = {
+    for s in data.virtfusion_servers.web.servers : s.hostname => s.primary_ipv4
+  }
+}
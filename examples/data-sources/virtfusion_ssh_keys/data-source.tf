@@ -0,0 +1,10 @@
+data "virtfusion_ssh_keys" "customer" {
+  user_id = 1
+}
+
+resource "virtfusion_build" "vm" {
+  server_id = virtfusion_server.vm.id
+  name      = "vm"
+  hostname  = "vm.example.com"
+  ssh_keys  = data.virtfusion_ssh_keys.customer.ids
+}
@@ -0,0 +1,9 @@
+data "virtfusion_user" "customer" {
+  ext_relation_id = 1042
+}
+
+resource "virtfusion_ssh" "customer_key" {
+  user_id    = data.virtfusion_user.customer.id
+  name       = "laptop"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGtQ3kP1xLq6yO0mQyqk0m0x7p3M5Z1kJ3q1nS0b9d0F laptop"
+}
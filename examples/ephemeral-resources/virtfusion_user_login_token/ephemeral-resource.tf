@@ -0,0 +1,4 @@
+ephemeral "virtfusion_user_login_token" "customer" {
+  ext_relation_id = virtfusion_user.customer.ext_relation_id
+  server_id       = virtfusion_server.node1.id
+}
@@ -0,0 +1,2 @@
+# Reverse DNS records are imported by server ID and address.
+terraform import virtfusion_reverse_dns.mail 42/203.0.113.25
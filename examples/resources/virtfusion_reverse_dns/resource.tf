@@ -0,0 +1,20 @@
+resource "virtfusion_server_ipv4" "mail" {
+  server_id = virtfusion_server.node1.id
+}
+
+resource "virtfusion_reverse_dns" "mail" {
+  server_id = virtfusion_server.node1.id
+  address   = virtfusion_server_ipv4.mail.address
+  hostname  = "mail.example.com"
+}
+
+# The primary address of an existing server
+data "virtfusion_server" "web" {
+  name = "web-01"
+}
+
+resource "virtfusion_reverse_dns" "web" {
+  server_id = data.virtfusion_server.web.id
+  address   = data.virtfusion_server.web.primary_ipv4
+  hostname  = "web-01.example.com"
+}
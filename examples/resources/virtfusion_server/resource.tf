@@ -11,4 +11,5 @@ resource "virtfusion_server" "node1" {
   outbound_network_speed = 100
   storage_profile        = 1
   network_profile        = 1
+  backup_plan_id         = 1
 }
@@ -0,0 +1,12 @@
+resource "virtfusion_server_backup" "pre_upgrade" {
+  server_id = virtfusion_server.node1.id
+
+  # Take a fresh backup whenever the application version changes
+  triggers = {
+    app_version = var.app_version
+  }
+
+  timeouts = {
+    create = "2h"
+  }
+}
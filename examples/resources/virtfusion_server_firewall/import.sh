@@ -0,0 +1,3 @@
+# Firewalls are imported by server ID and interface. The interface defaults
+# to primary when omitted.
+terraform import virtfusion_server_firewall.node1 42/secondary
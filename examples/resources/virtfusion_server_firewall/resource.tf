@@ -0,0 +1,24 @@
+resource "virtfusion_server_firewall" "node1" {
+  server_id = virtfusion_server.node1.id
+
+  rules = [
+    {
+      direction   = "in"
+      protocol    = "tcp"
+      ports       = "22"
+      source_cidr = "203.0.113.0/24"
+      action      = "accept"
+    },
+    {
+      direction = "in"
+      protocol  = "tcp"
+      ports     = "80,443"
+      action    = "accept"
+    },
+    {
+      direction = "in"
+      protocol  = "any"
+      action    = "drop"
+    },
+  ]
+}
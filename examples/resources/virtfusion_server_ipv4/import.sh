@@ -0,0 +1,2 @@
+# Addresses are imported by server ID and assignment ID.
+terraform import virtfusion_server_ipv4.extra 42/311
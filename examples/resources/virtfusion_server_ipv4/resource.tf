@@ -0,0 +1,15 @@
+# Next free address from any block available to the server
+resource "virtfusion_server_ipv4" "extra" {
+  server_id = virtfusion_server.node1.id
+}
+
+# A specific address from a specific block
+resource "virtfusion_server_ipv4" "mail" {
+  server_id = virtfusion_server.node1.id
+  block_id  = 3
+  address   = "203.0.113.25"
+}
+
+output "extra_address" {
+  value = virtfusion_server_ipv4.extra.address
+}
@@ -0,0 +1,2 @@
+# Subnets are imported by server ID and assignment ID.
+terraform import virtfusion_server_ipv6_subnet.extra 42/58
@@ -0,0 +1,8 @@
+resource "virtfusion_server_ipv6_subnet" "extra" {
+  server_id = virtfusion_server.node1.id
+  block_id  = 7
+}
+
+output "extra_subnet" {
+  value = virtfusion_server_ipv6_subnet.extra.subnet
+}
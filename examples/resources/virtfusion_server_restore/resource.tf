@@ -0,0 +1,13 @@
+data "virtfusion_server_backups" "node1" {
+  server_id = virtfusion_server.node1.id
+  status    = "complete"
+}
+
+resource "virtfusion_server_restore" "node1" {
+  server_id = virtfusion_server.node1.id
+  backup_id = data.virtfusion_server_backups.node1.backups[0].id
+
+  timeouts = {
+    create = "2h"
+  }
+}
@@ -0,0 +1,2 @@
+# VNC settings are imported by server ID.
+terraform import virtfusion_server_vnc.node1 42
@@ -0,0 +1,13 @@
+resource "virtfusion_server_vnc" "node1" {
+  server_id = virtfusion_server.node1.id
+  enabled   = true
+}
+
+output "node1_vnc" {
+  value = {
+    host     = virtfusion_server_vnc.node1.host
+    port     = virtfusion_server_vnc.node1.port
+    password = virtfusion_server_vnc.node1.password
+  }
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Users are imported by their external relation ID.
+terraform import virtfusion_user.customer 1042
@@ -0,0 +1,10 @@
+resource "virtfusion_user" "customer" {
+  ext_relation_id = 1042
+  name            = "Jane Doe"
+  email           = "jane@example.com"
+  self_service    = 1
+}
+
+resource "virtfusion_server" "node1" {
+  user_id = virtfusion_user.customer.id
+}
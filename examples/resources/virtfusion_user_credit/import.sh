@@ -0,0 +1,2 @@
+# Credits are imported by the user's external relation ID and the credit ID.
+terraform import virtfusion_user_credit.topup 1001/17
@@ -0,0 +1,5 @@
+resource "virtfusion_user_credit" "topup" {
+  ext_relation_id = virtfusion_user.customer.ext_relation_id
+  amount          = 50
+  reference       = "INV-2024-0042"
+}
@@ -0,0 +1,2 @@
+# Resource packs are imported by the user's external relation ID and the pack assignment ID.
+terraform import virtfusion_user_resource_pack.starter 1001/5
@@ -0,0 +1,4 @@
+resource "virtfusion_user_resource_pack" "starter" {
+  ext_relation_id = virtfusion_user.customer.ext_relation_id
+  pack_id         = 3
+}
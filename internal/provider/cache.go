@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"sync"
+	"time"
+)
+
+// lookupCache memoizes read-only API lookups (OS templates, packages,
+// hypervisor groups) for the lifetime of a provider instance. It is safe for
+// concurrent use; concurrent callers asking for the same key share a single
+// in-flight request.
+type lookupCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	ready   chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		ttl:     ttl,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// get returns the cached value for key, calling load to populate it when it is
+// missing or expired. Failed loads are not cached.
+func (c *lookupCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	if c == nil || c.ttl <= 0 {
+		return load()
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		select {
+		case <-entry.ready:
+			if time.Now().Before(entry.expires) {
+				c.mu.Unlock()
+				return entry.value, entry.err
+			}
+		default:
+			// Another caller is loading this key; wait for it
+			c.mu.Unlock()
+			<-entry.ready
+			return entry.value, entry.err
+		}
+	}
+
+	entry := &cacheEntry{ready: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	entry.value, entry.err = load()
+	entry.expires = time.Now().Add(c.ttl)
+
+	c.mu.Lock()
+	if entry.err != nil && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.ready)
+
+	return entry.value, entry.err
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLookupCacheGet(t *testing.T) {
+	errLoad := errors.New("load failed")
+
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		results   []error
+		wantCalls int
+		wantErrs  []error
+	}{
+		{
+			name:      "caches success",
+			ttl:       time.Minute,
+			results:   []error{nil, nil, nil},
+			wantCalls: 1,
+			wantErrs:  []error{nil, nil, nil},
+		},
+		{
+			name:      "evicts on error",
+			ttl:       time.Minute,
+			results:   []error{errLoad, nil, nil},
+			wantCalls: 2,
+			wantErrs:  []error{errLoad, nil, nil},
+		},
+		{
+			name:      "disabled without ttl",
+			ttl:       0,
+			results:   []error{nil, nil, nil},
+			wantCalls: 3,
+			wantErrs:  []error{nil, nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newLookupCache(tt.ttl)
+			calls := 0
+			for i, wantErr := range tt.wantErrs {
+				value, err := cache.get("key", func() (interface{}, error) {
+					result := tt.results[calls]
+					calls++
+					if result != nil {
+						return nil, result
+					}
+					return calls, nil
+				})
+				if !errors.Is(err, wantErr) {
+					t.Fatalf("get #%d: got error %v, want %v", i, err, wantErr)
+				}
+				if err == nil && value == nil {
+					t.Fatalf("get #%d: got nil value", i)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d loads, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestLookupCacheGetExpires(t *testing.T) {
+	cache := newLookupCache(time.Millisecond)
+	calls := 0
+	load := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	if _, err := cache.get("key", load); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	value, err := cache.get("key", load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 2 {
+		t.Errorf("got %v after expiry, want 2", value)
+	}
+}
+
+func TestLookupCacheGetConcurrent(t *testing.T) {
+	cache := newLookupCache(time.Minute)
+	release := make(chan struct{})
+	var mu sync.Mutex
+	calls := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = cache.get("key", func() (interface{}, error) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				<-release
+				return "value", nil
+			})
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d loads for concurrent callers, want 1", calls)
+	}
+}
@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// osTemplate is a single OS template as returned by the VirtFusion API.
+type osTemplate struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Variant     string `json:"variant"`
+	Arch        int64  `json:"arch"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	DeployType  int64  `json:"deploy_type"`
+	Distro      string `json:"distro"`
+}
+
+// osTemplateGroup is a group of templates (usually one per distribution) as
+// returned by the package-scoped templates endpoint.
+type osTemplateGroup struct {
+	Name      string       `json:"name"`
+	Templates []osTemplate `json:"templates"`
+}
+
+// matches reports whether the template is identified by the given name. The
+// bare template name, "name version" and "name version variant" forms are all
+// accepted, compared case-insensitively.
+func (t osTemplate) matches(name string) bool {
+	full := t.Name
+	if strings.EqualFold(full, name) {
+		return true
+	}
+	for _, part := range []string{t.Version, t.Variant} {
+		if part == "" {
+			continue
+		}
+		full += " " + part
+		if strings.EqualFold(full, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// fullName returns the template name including its version and variant.
+func (t osTemplate) fullName() string {
+	full := t.Name
+	for _, part := range []string{t.Version, t.Variant} {
+		if part != "" {
+			full += " " + part
+		}
+	}
+	return full
+}
+
+// architecture returns the human readable CPU architecture of the template.
+func (t osTemplate) architecture() string {
+	switch t.Arch {
+	case 1:
+		return "x86_64"
+	case 2:
+		return "aarch64"
+	default:
+		return ""
+	}
+}
+
+// resourcePackage is a server package as returned by the VirtFusion API.
+type resourcePackage struct {
+	ID                   int64  `json:"id"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	Enabled              bool   `json:"enabled"`
+	Memory               int64  `json:"memory"`
+	CPUCores             int64  `json:"cpuCores"`
+	PrimaryStorage       int64  `json:"primaryStorage"`
+	Traffic              int64  `json:"traffic"`
+	NetworkSpeedInbound  int64  `json:"primaryNetworkSpeedIn"`
+	NetworkSpeedOutbound int64  `json:"primaryNetworkSpeedOut"`
+}
+
+// hypervisorGroup is a hypervisor group (location) as returned by the VirtFusion API.
+type hypervisorGroup struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Default     bool   `json:"default"`
+}
+
+// hypervisor is a single hypervisor as returned by the VirtFusion API.
+type hypervisor struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Hostname    string `json:"hostname"`
+	IP          string `json:"ip"`
+	Enabled     bool   `json:"enabled"`
+	Maintenance bool   `json:"maintenance"`
+	Group       struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"group"`
+}
+
+// resourceUsage is the total/allocated/free breakdown of one hypervisor resource.
+type resourceUsage struct {
+	Total     int64 `json:"total"`
+	Allocated int64 `json:"allocated"`
+	Free      int64 `json:"free"`
+}
+
+// hypervisorResources is the current capacity of a hypervisor.
+type hypervisorResources struct {
+	ID       int64         `json:"id"`
+	Memory   resourceUsage `json:"memory"`
+	CPUCores resourceUsage `json:"cpuCores"`
+	Storage  resourceUsage `json:"localStorage"`
+	Servers  resourceUsage `json:"servers"`
+}
+
+// fits reports whether the hypervisor has enough free capacity for a server
+// with the given memory (MB), CPU cores and storage (GB). A server limit of 0
+// means the hypervisor takes any number of servers.
+func (h hypervisorResources) fits(memory, cores, storage int64) bool {
+	if h.Servers.Total > 0 && h.Servers.Free <= 0 {
+		return false
+	}
+	return h.Memory.Free >= memory && h.CPUCores.Free >= cores && h.Storage.Free >= storage
+}
+
+// fetchJSON issues a GET request against the API and decodes the response body into out.
+func fetchJSON(client *http.Client, endpoint, apiToken, apiPath string, out interface{}) error {
+	httpReq, _ := http.NewRequest("GET", endpoint+"/api/v1"+apiPath, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+apiToken)
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("unexpected status %d while fetching %s", httpResp.StatusCode, apiPath)
+	}
+
+	return json.NewDecoder(httpResp.Body).Decode(out)
+}
+
+// serverOsTemplates returns the OS templates the given server is allowed to be
+// built with. Availability is decided by the server's package, so only the
+// package lookup is made per server; the template list itself is cached per
+// package and shared by every server built from it.
+func (c *ProviderConfig) serverOsTemplates(serverID int64) ([]osTemplate, error) {
+	var respData struct {
+		Data struct {
+			PackageID int64 `json:"packageId"`
+		} `json:"data"`
+	}
+	if err := fetchJSON(c.Client, c.Endpoint, c.ApiToken, "/servers/"+strconv.FormatInt(serverID, 10), &respData); err != nil {
+		return nil, err
+	}
+	return c.packageOsTemplates(respData.Data.PackageID)
+}
+
+// packageOsTemplates returns the OS templates available to servers built from
+// the given package.
+func (c *ProviderConfig) packageOsTemplates(packageID int64) ([]osTemplate, error) {
+	apiPath := "/media/templates/fromServerPackageSpec/" + strconv.FormatInt(packageID, 10)
+	value, err := c.Cache.get(apiPath, func() (interface{}, error) {
+		groups, err := fetchAll[osTemplateGroup](c, apiPath)
+		if err != nil {
+			return nil, err
+		}
+
+		var templates []osTemplate
+		for _, group := range groups {
+			for _, tpl := range group.Templates {
+				if tpl.Distro == "" {
+					tpl.Distro = group.Name
+				}
+				templates = append(templates, tpl)
+			}
+		}
+		return templates, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	templates, _ := value.([]osTemplate)
+	return templates, nil
+}
+
+// osTemplates returns every OS template defined in the panel, regardless of
+// which packages or servers may use it.
+func (c *ProviderConfig) osTemplates() ([]osTemplate, error) {
+	apiPath := "/os-templates"
+	value, err := c.Cache.get(apiPath, func() (interface{}, error) {
+		return fetchAll[osTemplate](c, apiPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	templates, _ := value.([]osTemplate)
+	return templates, nil
+}
+
+// packages returns every server package defined in the panel.
+func (c *ProviderConfig) packages() ([]resourcePackage, error) {
+	apiPath := "/packages"
+	value, err := c.Cache.get(apiPath, func() (interface{}, error) {
+		return fetchAll[resourcePackage](c, apiPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	packages, _ := value.([]resourcePackage)
+	return packages, nil
+}
+
+// hypervisorGroups returns every hypervisor group defined in the panel.
+func (c *ProviderConfig) hypervisorGroups() ([]hypervisorGroup, error) {
+	apiPath := "/compute/hypervisors/groups"
+	value, err := c.Cache.get(apiPath, func() (interface{}, error) {
+		return fetchAll[hypervisorGroup](c, apiPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	groups, _ := value.([]hypervisorGroup)
+	return groups, nil
+}
+
+// hypervisors returns every hypervisor defined in the panel.
+func (c *ProviderConfig) hypervisors() ([]hypervisor, error) {
+	apiPath := "/compute/hypervisors"
+	value, err := c.Cache.get(apiPath, func() (interface{}, error) {
+		return fetchAll[hypervisor](c, apiPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	hypervisors, _ := value.([]hypervisor)
+	return hypervisors, nil
+}
+
+// hypervisorGroupResources returns the current capacity of every hypervisor in
+// the group. Capacity changes as servers are built, so it is never cached.
+func (c *ProviderConfig) hypervisorGroupResources(groupID int64) ([]hypervisorResources, error) {
+	apiPath := "/compute/hypervisors/groups/" + strconv.FormatInt(groupID, 10) + "/resources"
+	return fetchAll[hypervisorResources](c, apiPath)
+}
+
+// fetchServers returns every server visible to the API token, or only those
+// owned by userID when it is non-zero. Server details change constantly, so
+// the list is never cached.
+func fetchServers(config *ProviderConfig, userID int64) ([]apiServer, error) {
+	apiPath := "/servers?remoteState=true"
+	if userID > 0 {
+		apiPath = "/servers/user/" + strconv.FormatInt(userID, 10) + "?remoteState=true"
+	}
+	return fetchAll[apiServer](config, apiPath)
+}
+
+// fetchUsers returns every panel user.
+func fetchUsers(config *ProviderConfig) ([]apiUser, error) {
+	return fetchAll[apiUser](config, "/users")
+}
+
+// fetchUserByExtRelation returns the user with the given external relation ID.
+func fetchUserByExtRelation(config *ProviderConfig, extRelationID int64) (apiUser, error) {
+	var respData struct {
+		Data apiUser `json:"data"`
+	}
+	apiPath := "/users/" + strconv.FormatInt(extRelationID, 10) + "/byExtRelation"
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, apiPath, &respData); err != nil {
+		return apiUser{}, err
+	}
+	return respData.Data, nil
+}
+
+// fetchSSHKeys returns the SSH keys belonging to a user. Keys are created and
+// removed alongside builds, so the list is never cached.
+func fetchSSHKeys(config *ProviderConfig, userID int64) ([]apiSSHKey, error) {
+	return fetchAll[apiSSHKey](config, "/ssh-keys/user/"+strconv.FormatInt(userID, 10))
+}
+
+// fetchServerBackups returns every backup of a server.
+func fetchServerBackups(config *ProviderConfig, serverID int64) ([]apiBackup, error) {
+	return fetchAll[apiBackup](config, "/servers/"+strconv.FormatInt(serverID, 10)+"/backups")
+}
+
+// fetchServerTraffic returns a server's traffic usage. Usage grows
+// constantly, so it is never cached.
+func fetchServerTraffic(config *ProviderConfig, serverID int64) (apiServerTraffic, error) {
+	var respData struct {
+		Data apiServerTraffic `json:"data"`
+	}
+	apiPath := "/servers/" + strconv.FormatInt(serverID, 10) + "/traffic"
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, apiPath, &respData); err != nil {
+		return apiServerTraffic{}, err
+	}
+	return respData.Data, nil
+}
+
+// ipBlock is an IPv4 or IPv6 block as returned by the VirtFusion API.
+type ipBlock struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	IPVersion        int64  `json:"ipVersion"`
+	Private          bool   `json:"private"`
+	Subnet           string `json:"subnet"`
+	Cidr             int64  `json:"cidr"`
+	Gateway          string `json:"gateway"`
+	Free             int64  `json:"free"`
+	HypervisorGroups []struct {
+		ID int64 `json:"id"`
+	} `json:"hypervisorGroups"`
+}
+
+// blockType returns "private" or "public".
+func (b ipBlock) blockType() string {
+	if b.Private {
+		return "private"
+	}
+	return "public"
+}
+
+// inHypervisorGroup reports whether the block is assigned to the group.
+func (b ipBlock) inHypervisorGroup(groupID int64) bool {
+	for _, g := range b.HypervisorGroups {
+		if g.ID == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchIPBlocks returns every IP block. Free address counts change with
+// every allocation, so the list is never cached.
+func fetchIPBlocks(config *ProviderConfig) ([]ipBlock, error) {
+	return fetchAll[ipBlock](config, "/connectivity/ipblocks")
+}
+
+// resolveOsTemplateToID resolves a template name to its numeric ID among the
+// templates available to the given server.
+func resolveOsTemplateToID(config *ProviderConfig, serverID int64, templateName string) (int64, error) {
+	templates, err := config.serverOsTemplates(serverID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tpl := range templates {
+		if tpl.matches(templateName) {
+			return tpl.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("OS template %q not found or not available to server %d", templateName, serverID)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import "testing"
+
+func TestOsTemplateMatches(t *testing.T) {
+	ubuntu := osTemplate{Name: "Ubuntu Server", Version: "22.04", Variant: "Minimal"}
+	noVersion := osTemplate{Name: "Alpine", Variant: "Edge"}
+
+	tests := []struct {
+		name     string
+		template osTemplate
+		query    string
+		want     bool
+	}{
+		{"bare name", ubuntu, "Ubuntu Server", true},
+		{"name and version", ubuntu, "Ubuntu Server 22.04", true},
+		{"full name", ubuntu, "Ubuntu Server 22.04 Minimal", true},
+		{"bare name case", ubuntu, "ubuntu server", true},
+		{"name and version case", ubuntu, "UBUNTU SERVER 22.04", true},
+		{"full name case", ubuntu, "ubuntu server 22.04 minimal", true},
+		{"name and variant without version", ubuntu, "Ubuntu Server Minimal", false},
+		{"other version", ubuntu, "Ubuntu Server 24.04", false},
+		{"prefix", ubuntu, "Ubuntu", false},
+		{"empty query", ubuntu, "", false},
+		{"missing version", noVersion, "Alpine Edge", true},
+		{"missing version case", noVersion, "alpine edge", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.template.matches(tt.query); got != tt.want {
+				t.Errorf("%+v.matches(%q) = %v, want %v", tt.template, tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHypervisorResourcesFits(t *testing.T) {
+	hv := hypervisorResources{
+		Memory:   resourceUsage{Free: 4096},
+		CPUCores: resourceUsage{Free: 4},
+		Storage:  resourceUsage{Free: 100},
+	}
+	full := hv
+	full.Servers = resourceUsage{Total: 10, Allocated: 10, Free: 0}
+	roomy := hv
+	roomy.Servers = resourceUsage{Total: 10, Allocated: 9, Free: 1}
+
+	tests := []struct {
+		name                   string
+		hv                     hypervisorResources
+		memory, cores, storage int64
+		want                   bool
+	}{
+		{"exact fit", hv, 4096, 4, 100, true},
+		{"nothing requested", hv, 0, 0, 0, true},
+		{"too much memory", hv, 4097, 1, 1, false},
+		{"too many cores", hv, 1, 5, 1, false},
+		{"too much storage", hv, 1, 1, 101, false},
+		{"server limit reached", full, 1, 1, 1, false},
+		{"server limit not reached", roomy, 1, 1, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hv.fits(tt.memory, tt.cores, tt.storage); got != tt.want {
+				t.Errorf("fits(%d, %d, %d) = %v, want %v", tt.memory, tt.cores, tt.storage, got, tt.want)
+			}
+		})
+	}
+}
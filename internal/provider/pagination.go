@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// pageEnvelope covers the paginated response shapes returned by list
+// endpoints: Laravel's plain paginator (next_page_url/current_page/last_page)
+// and its API resource form (links.next/meta).
+type pageEnvelope struct {
+	Data        json.RawMessage `json:"data"`
+	NextPageURL string          `json:"next_page_url"`
+	CurrentPage int             `json:"current_page"`
+	LastPage    int             `json:"last_page"`
+	Links       json.RawMessage `json:"links"`
+	Meta        struct {
+		CurrentPage int `json:"current_page"`
+		LastPage    int `json:"last_page"`
+	} `json:"meta"`
+}
+
+// currentPage returns the page number reported by the envelope, or 0 when the
+// response is not paginated.
+func (e pageEnvelope) currentPage() int {
+	if e.CurrentPage != 0 {
+		return e.CurrentPage
+	}
+	return e.Meta.CurrentPage
+}
+
+// nextPage returns the number of the page following this one, or 0 when this
+// is the last page.
+func (e pageEnvelope) nextPage() int {
+	next := e.NextPageURL
+	if next == "" && len(e.Links) > 0 {
+		// links is an object for API resources but a list of page links for
+		// the plain paginator, which carries next_page_url instead
+		var links struct {
+			Next string `json:"next"`
+		}
+		if err := json.Unmarshal(e.Links, &links); err == nil {
+			next = links.Next
+		}
+	}
+
+	current, last := e.CurrentPage, e.LastPage
+	if current == 0 {
+		current, last = e.Meta.CurrentPage, e.Meta.LastPage
+	}
+
+	if next != "" {
+		if u, err := url.Parse(next); err == nil {
+			if page, err := strconv.Atoi(u.Query().Get("page")); err == nil && page > current {
+				return page
+			}
+		}
+	}
+	if current > 0 && current < last {
+		return current + 1
+	}
+	return 0
+}
+
+// paginate iterates over every item of a list endpoint, requesting further
+// pages as the caller consumes items. Endpoints that return a bare array or an
+// unpaginated {"data": [...]} envelope are treated as a single page. Iteration
+// stops after the first error, which is yielded with a zero item.
+func paginate[T any](config *ProviderConfig, apiPath string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		u, err := url.Parse(apiPath)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		query := u.Query()
+		if config.PageSize > 0 {
+			query.Set("results", strconv.FormatInt(config.PageSize, 10))
+		}
+
+		for page := 1; page > 0; {
+			query.Set("page", strconv.Itoa(page))
+			u.RawQuery = query.Encode()
+
+			var raw json.RawMessage
+			if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, u.String(), &raw); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var items []T
+			var envelope pageEnvelope
+			if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+				err = json.Unmarshal(raw, &items)
+			} else if err = json.Unmarshal(raw, &envelope); err == nil && len(envelope.Data) > 0 {
+				err = json.Unmarshal(envelope.Data, &items)
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// An endpoint that ignores the page parameter keeps returning the
+			// first page while reporting more pages, which would never end
+			if current := envelope.currentPage(); current != 0 && current != page {
+				yield(zero, fmt.Errorf("requested page %d of %s but the API returned page %d", page, apiPath, current))
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) == 0 {
+				return
+			}
+			next := envelope.nextPage()
+			if next != 0 && next <= page {
+				yield(zero, fmt.Errorf("pagination of %s did not advance past page %d", apiPath, page))
+				return
+			}
+			page = next
+		}
+	}
+}
+
+// fetchAll collects every item of a list endpoint, following pagination.
+func fetchAll[T any](config *ProviderConfig, apiPath string) ([]T, error) {
+	var items []T
+	for item, err := range paginate[T](config, apiPath) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
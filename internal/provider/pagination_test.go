@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestPageEnvelopeNextPage(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{
+			name: "plain paginator with next url",
+			body: `{"current_page": 1, "last_page": 3, "next_page_url": "https://panel.example.com/api/v1/servers?page=2"}`,
+			want: 2,
+		},
+		{
+			name: "plain paginator on last page",
+			body: `{"current_page": 3, "last_page": 3, "next_page_url": null}`,
+			want: 0,
+		},
+		{
+			name: "plain paginator without next url",
+			body: `{"current_page": 1, "last_page": 2}`,
+			want: 2,
+		},
+		{
+			name: "plain paginator with link list",
+			body: `{"current_page": 2, "last_page": 3, "links": [{"url": null, "label": "Previous"}]}`,
+			want: 3,
+		},
+		{
+			name: "api resource with links and meta",
+			body: `{"links": {"next": "https://panel.example.com/api/v1/users?page=3"}, "meta": {"current_page": 2, "last_page": 5}}`,
+			want: 3,
+		},
+		{
+			name: "api resource on last page",
+			body: `{"links": {"next": null}, "meta": {"current_page": 5, "last_page": 5}}`,
+			want: 0,
+		},
+		{
+			name: "next url pointing backwards",
+			body: `{"current_page": 2, "last_page": 3, "next_page_url": "https://panel.example.com/api/v1/servers?page=1"}`,
+			want: 3,
+		},
+		{
+			name: "unpaginated",
+			body: `{"data": []}`,
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var envelope pageEnvelope
+			if err := json.Unmarshal([]byte(tt.body), &envelope); err != nil {
+				t.Fatal(err)
+			}
+			if got := envelope.nextPage(); got != tt.want {
+				t.Errorf("nextPage() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(page int) string
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "bare array",
+			handler: func(page int) string {
+				return `[1, 2, 3]`
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "unpaginated envelope",
+			handler: func(page int) string {
+				return `{"data": [1, 2]}`
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "follows pages",
+			handler: func(page int) string {
+				return fmt.Sprintf(`{"data": [%d], "current_page": %d, "last_page": 3}`, page, page)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "stops on empty page",
+			handler: func(page int) string {
+				if page > 1 {
+					return `{"data": [], "current_page": 2, "last_page": 9}`
+				}
+				return `{"data": [1], "current_page": 1, "last_page": 9}`
+			},
+			want: []int{1},
+		},
+		{
+			name: "page parameter ignored",
+			handler: func(page int) string {
+				return `{"data": [1], "current_page": 1, "last_page": 3}`
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests > 10 {
+					http.Error(w, "too many requests", http.StatusTooManyRequests)
+					return
+				}
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				fmt.Fprint(w, tt.handler(page))
+			}))
+			defer server.Close()
+
+			config := &ProviderConfig{Client: server.Client(), Endpoint: server.URL}
+			got, err := fetchAll[int](config, "/items")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				if requests > 2 {
+					t.Errorf("made %d requests before failing, want at most 2", requests)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,16 +10,21 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure VirtfusionProvider satisfies provider.Provider interface
 var _ provider.Provider = &VirtfusionProvider{}
+var _ provider.ProviderWithEphemeralResources = &VirtfusionProvider{}
 
 // VirtfusionProvider implements the provider.
 type VirtfusionProvider struct {
@@ -36,6 +41,8 @@ type ProviderConfig struct {
 	PublicIPs       int64
 	PrivateIPs      int64
 	HypervisorGroup int64
+	PageSize        int64
+	Cache           *lookupCache
 }
 
 // VirtfusionProviderModel describes the provider schema.
@@ -47,6 +54,8 @@ type VirtfusionProviderModel struct {
 	PublicIPs       types.Int64  `tfsdk:"public_ips"`
 	PrivateIPs      types.Int64  `tfsdk:"private_ips"`
 	HypervisorGroup types.Int64  `tfsdk:"hypervisor_group"`
+	LookupCacheTTL  types.Int64  `tfsdk:"lookup_cache_ttl"`
+	PageSize        types.Int64  `tfsdk:"page_size"`
 }
 
 func (p *VirtfusionProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -86,6 +95,20 @@ func (p *VirtfusionProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				MarkdownDescription: "Default hypervisor group ID (location).",
 				Optional:            true,
 			},
+			"lookup_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to cache OS template, package and hypervisor group lookups (default: 300, 0 disables caching).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items to request per page from list endpoints (default: 100).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -105,6 +128,8 @@ func (p *VirtfusionProvider) Configure(ctx context.Context, req provider.Configu
 	publicIPs := int64(1)
 	privateIPs := int64(0)
 	hypervisorGroup := int64(1)
+	lookupCacheTTL := int64(300)
+	pageSize := int64(100)
 
 	// Override from config
 	if !data.Endpoint.IsNull() {
@@ -157,6 +182,22 @@ func (p *VirtfusionProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
+	if !data.LookupCacheTTL.IsNull() {
+		lookupCacheTTL = data.LookupCacheTTL.ValueInt64()
+	} else if env := os.Getenv("VIRTFUSION_LOOKUP_CACHE_TTL"); env != "" {
+		if v, err := strconv.ParseInt(env, 10, 64); err == nil && v >= 0 {
+			lookupCacheTTL = v
+		}
+	}
+
+	if !data.PageSize.IsNull() {
+		pageSize = data.PageSize.ValueInt64()
+	} else if env := os.Getenv("VIRTFUSION_PAGE_SIZE"); env != "" {
+		if v, err := strconv.ParseInt(env, 10, 64); err == nil && v >= 1 {
+			pageSize = v
+		}
+	}
+
 	if apiToken == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Token",
@@ -183,10 +224,13 @@ func (p *VirtfusionProvider) Configure(ctx context.Context, req provider.Configu
 		PublicIPs:       publicIPs,
 		PrivateIPs:      privateIPs,
 		HypervisorGroup: hypervisorGroup,
+		PageSize:        pageSize,
+		Cache:           newLookupCache(time.Duration(lookupCacheTTL) * time.Second),
 	}
 
 	resp.DataSourceData = config
 	resp.ResourceData = config
+	resp.EphemeralResourceData = config
 }
 
 func (p *VirtfusionProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -194,11 +238,43 @@ func (p *VirtfusionProvider) Resources(ctx context.Context) []func() resource.Re
 		NewVirtfusionServerResource,
 		NewVirtfusionServerBuildResource,
 		NewVirtfusionSSHResource,
+		NewVirtfusionUserResource,
+		NewVirtfusionUserCreditResource,
+		NewVirtfusionUserResourcePackResource,
+		NewVirtfusionServerBackupResource,
+		NewVirtfusionServerRestoreResource,
+		NewVirtfusionServerFirewallResource,
+		NewVirtfusionServerIPv4Resource,
+		NewVirtfusionServerIPv6SubnetResource,
+		NewVirtfusionReverseDNSResource,
+		NewVirtfusionServerVNCResource,
 	}
 }
 
 func (p *VirtfusionProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewVirtfusionOsTemplateDataSource,
+		NewVirtfusionOsTemplatesDataSource,
+		NewVirtfusionPackageDataSource,
+		NewVirtfusionPackagesDataSource,
+		NewVirtfusionHypervisorDataSource,
+		NewVirtfusionHypervisorsDataSource,
+		NewVirtfusionHypervisorGroupDataSource,
+		NewVirtfusionHypervisorGroupsDataSource,
+		NewVirtfusionServerDataSource,
+		NewVirtfusionServersDataSource,
+		NewVirtfusionUserDataSource,
+		NewVirtfusionSSHKeysDataSource,
+		NewVirtfusionServerBackupsDataSource,
+		NewVirtfusionIPBlocksDataSource,
+		NewVirtfusionServerTrafficDataSource,
+	}
+}
+
+func (p *VirtfusionProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewVirtfusionUserLoginTokenEphemeralResource,
+	}
 }
 
 type CustomTransport struct {
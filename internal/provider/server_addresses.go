@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// assignmentPayload returns the request body shared by IPv4 address and IPv6
+// subnet assignments. The block is only sent when one was configured.
+func assignmentPayload(iface types.String, blockID types.Int64) map[string]interface{} {
+	payload := map[string]interface{}{
+		"interface": iface.ValueString(),
+	}
+	if !blockID.IsUnknown() && !blockID.IsNull() {
+		payload["blockId"] = blockID.ValueInt64()
+	}
+	return payload
+}
+
+// assignServerAddress assigns an additional IPv4 address or IPv6 subnet to a
+// server, depending on family ("ipv4" or "ipv6"), and decodes the new
+// assignment into out.
+func assignServerAddress(config *ProviderConfig, serverID int64, family string, payload map[string]interface{}, out interface{}) error {
+	body, _ := json.Marshal(payload)
+	reqURL := config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/" + family
+	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+config.ApiToken)
+
+	httpResp, err := config.Client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		return fmt.Errorf("unexpected status %d while assigning %s to server %d", httpResp.StatusCode, family, serverID)
+	}
+
+	respData := struct {
+		Data interface{} `json:"data"`
+	}{Data: out}
+	return json.NewDecoder(httpResp.Body).Decode(&respData)
+}
+
+// releaseServerAddress releases an assignment made by assignServerAddress.
+// An assignment that is already gone is not an error.
+func releaseServerAddress(config *ProviderConfig, serverID int64, family string, id int64) error {
+	reqURL := config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/" + family + "/" + strconv.FormatInt(id, 10)
+	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+config.ApiToken)
+
+	httpResp, err := config.Client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		return fmt.Errorf("unexpected status %d while releasing %s assignment %d", httpResp.StatusCode, family, id)
+	}
+	return nil
+}
+
+// importServerAddress imports an assignment by "<server_id>/<id>". The
+// interface starts out as primary and is corrected by the following Read.
+func importServerAddress(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverPart, idPart, ok := strings.Cut(req.ID, "/")
+	serverID, serverErr := strconv.ParseInt(serverPart, 10, 64)
+	id, idErr := strconv.ParseInt(idPart, 10, 64)
+	if !ok || serverErr != nil || idErr != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected <server_id>/<id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), "primary")...)
+}
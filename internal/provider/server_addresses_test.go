@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAssignServerAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    apiServerIPv4
+		wantErr bool
+	}{
+		{
+			name:   "created",
+			status: http.StatusCreated,
+			body:   `{"data": {"id": 311, "blockId": 4, "address": "203.0.113.25", "gateway": "203.0.113.1", "netmask": "255.255.255.0"}}`,
+			want:   apiServerIPv4{ID: 311, BlockID: 4, Address: "203.0.113.25", Gateway: "203.0.113.1", Netmask: "255.255.255.0"},
+		},
+		{
+			name:    "block exhausted",
+			status:  http.StatusUnprocessableEntity,
+			body:    `{"errors": ["no free addresses"]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			var gotPayload map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.Method + " " + r.URL.Path
+				_ = json.NewDecoder(r.Body).Decode(&gotPayload)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			config := &ProviderConfig{Client: server.Client(), Endpoint: server.URL}
+			payload := map[string]interface{}{"interface": "primary", "blockId": 4}
+			var got apiServerIPv4
+			err := assignServerAddress(config, 42, "ipv4", payload, &got)
+			if gotPath != "POST /api/v1/servers/42/ipv4" {
+				t.Errorf("requested %q", gotPath)
+			}
+			if gotPayload["interface"] != "primary" || gotPayload["blockId"] != float64(4) {
+				t.Errorf("sent %v", gotPayload)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseServerAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"released", http.StatusNoContent, false},
+		{"already gone", http.StatusNotFound, false},
+		{"server error", http.StatusInternalServerError, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.Method + " " + r.URL.Path
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			config := &ProviderConfig{Client: server.Client(), Endpoint: server.URL}
+			err := releaseServerAddress(config, 42, "ipv6", 58)
+			if gotPath != "DELETE /api/v1/servers/42/ipv6/58" {
+				t.Errorf("requested %q", gotPath)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/crypto/ssh"
+)
+
+// parseSSHPublicKey parses a single public key in OpenSSH authorized_keys
+// format, e.g. "ssh-ed25519 AAAA... comment". Options and comments are
+// accepted but ignored.
+func parseSSHPublicKey(s string) (ssh.PublicKey, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("public key is empty")
+	}
+	if strings.Contains(s, "\n") {
+		return nil, fmt.Errorf("expected a single public key, got multiple lines")
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("not a valid OpenSSH public key: %w", err)
+	}
+	if !supportedSSHKeyTypes[key.Type()] {
+		return nil, fmt.Errorf("unsupported key type %q; expected an rsa, ed25519, ecdsa or security key", key.Type())
+	}
+	return key, nil
+}
+
+// supportedSSHKeyTypes lists the key types accepted by parseSSHPublicKey. DSA
+// keys are obsolete and certificates cannot be added as plain keys.
+var supportedSSHKeyTypes = map[string]bool{
+	ssh.KeyAlgoRSA:        true,
+	ssh.KeyAlgoED25519:    true,
+	ssh.KeyAlgoECDSA256:   true,
+	ssh.KeyAlgoECDSA384:   true,
+	ssh.KeyAlgoECDSA521:   true,
+	ssh.KeyAlgoSKED25519:  true,
+	ssh.KeyAlgoSKECDSA256: true,
+}
+
+// sshPublicKeyValidator checks that a string is a single OpenSSH public key
+// (rsa, ed25519, ecdsa or security key types).
+type sshPublicKeyValidator struct{}
+
+var _ validator.String = sshPublicKeyValidator{}
+
+func (v sshPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be a single rsa, ed25519, ecdsa or security key public key in OpenSSH authorized_keys format"
+}
+
+func (v sshPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSSHPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SSH Public Key", err.Error())
+	}
+}
+
+// sshPublicKeyType is a string type whose values compare equal when they hold
+// the same key material, regardless of whitespace, options or comment.
+type sshPublicKeyType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = sshPublicKeyType{}
+
+func (t sshPublicKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(sshPublicKeyType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t sshPublicKeyType) String() string {
+	return "sshPublicKeyType"
+}
+
+func (t sshPublicKeyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return sshPublicKeyValue{StringValue: in}, nil
+}
+
+func (t sshPublicKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return sshPublicKeyValue{StringValue: stringValue}, nil
+}
+
+func (t sshPublicKeyType) ValueType(ctx context.Context) attr.Value {
+	return sshPublicKeyValue{}
+}
+
+// sshPublicKeyValue is a value of sshPublicKeyType.
+type sshPublicKeyValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = sshPublicKeyValue{}
+
+func newSSHPublicKeyValue(s string) sshPublicKeyValue {
+	return sshPublicKeyValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (v sshPublicKeyValue) Equal(o attr.Value) bool {
+	other, ok := o.(sshPublicKeyValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v sshPublicKeyValue) Type(ctx context.Context) attr.Type {
+	return sshPublicKeyType{}
+}
+
+// StringSemanticEquals reports whether both values hold the same key material.
+// Values that fail to parse are only equal if they are identical strings.
+func (v sshPublicKeyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(sshPublicKeyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldKey, err := parseSSHPublicKey(v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	newKey, err := parseSSHPublicKey(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return bytes.Equal(oldKey.Marshal(), newKey.Marshal()), diags
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"golang.org/x/crypto/ssh"
+)
+
+// dsaPublicKey is an ssh-dss key, which parseSSHPublicKey must reject.
+const dsaPublicKey = "ssh-dss AAAAB3NzaC1kc3MAAACBAL1fE1ZYME68AIBSJItyP6dlE/M/RgD0OTRS+5ysVMgMvmcf56zqXHQewLUQNeYi48ddGtr8T/TKXhchkayl+lr0wbV5I/35dOs/Hzh/zZ3+bMoxKhAWcB3t6dBsSj1y3P91fVbpSZgfceanfSyN1+kRKp+tQvq3ipmB2fANsTTlAAAAFQCadNPYW2h7K3Bz7FsGOWqdRjJ26wAAAIEAs1y4x5qxQyrPb7XouEFh9m0Xh6+xEvZGZeYz3cMWs1wKzxnB5qH+0NP3Mjawms5FbioUbgUsMDKhTeemcN0KIZ1qhPCH9SdVG/7vRNJaLOSDocWIXUbL+gcldPq/xTllhh9Bx1jRqoymQPA/AsooxtrDWk0AyFTF8MJ24z1E0S0AAACBAKZ7xgBmWsF51iBN+o7Oa+lE70h5eCw8bYvTcLsTgebo0SBz4PLHm8FCyiX4BXwB+oHOCkeVP4bfOignFi4Ks35znKGjTxql9QHC7SPnmnWfLVUveWEMwzFOQzVl9V9oRgiMWSJqlyNsJV5Td0mMmVMJ74We/PxzSDTAHG0xoTZb user@dsa"
+
+// newTestPublicKey returns a freshly generated ed25519 key and the same key
+// in authorized_keys format without a comment.
+func newTestPublicKey(t *testing.T) (ssh.PublicKey, string) {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+func TestParseSSHPublicKey(t *testing.T) {
+	_, ed25519Key := newTestPublicKey(t)
+
+	ecdsaPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaPub, err := ssh.NewPublicKey(&ecdsaPriv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(ecdsaPub)))
+
+	_, caPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caSigner, err := ssh.NewSignerFromKey(caPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := &ssh.Certificate{Key: ecdsaPub, CertType: ssh.UserCert, KeyId: "test"}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		t.Fatal(err)
+	}
+	certKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert)))
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "ed25519", key: ed25519Key},
+		{name: "ed25519 with comment", key: ed25519Key + " user@example.com"},
+		{name: "surrounding whitespace", key: "  " + ed25519Key + "\n"},
+		{name: "ecdsa", key: ecdsaKey},
+		{name: "empty", key: "  ", wantErr: "empty"},
+		{name: "multiple lines", key: ed25519Key + "\n" + ecdsaKey, wantErr: "multiple lines"},
+		{name: "garbage", key: "ssh-ed25519 not-base64", wantErr: "not a valid"},
+		{name: "dsa", key: dsaPublicKey, wantErr: "unsupported key type"},
+		{name: "certificate", key: certKey, wantErr: "unsupported key type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSSHPublicKey(tt.key)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyStringSemanticEquals(t *testing.T) {
+	_, key := newTestPublicKey(t)
+	_, otherKey := newTestPublicKey(t)
+
+	tests := []struct {
+		name     string
+		oldValue string
+		newValue string
+		want     bool
+	}{
+		{"identical", key, key, true},
+		{"comment added", key, key + " user@example.com", true},
+		{"comment changed", key + " old@example.com", key + " new@example.com", true},
+		{"whitespace", key, "  " + key + "\n", true},
+		{"different key", key, otherKey, false},
+		{"old invalid, same string", "not a key", "not a key", true},
+		{"old invalid, different string", "not a key", key, false},
+		{"new invalid", key, "not a key", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := newSSHPublicKeyValue(tt.oldValue).StringSemanticEquals(context.Background(), newSSHPublicKeyValue(tt.newValue))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.oldValue, tt.newValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyStringSemanticEqualsWrongType(t *testing.T) {
+	_, key := newTestPublicKey(t)
+
+	_, diags := newSSHPublicKeyValue(key).StringSemanticEquals(context.Background(), basetypes.NewStringValue(key))
+	if !diags.HasError() {
+		t.Error("expected an error for a plain string value")
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cidrValidator checks that a string is an IPv4 or IPv6 network in CIDR
+// notation, e.g. "203.0.113.0/24".
+type cidrValidator struct{}
+
+var _ validator.String = cidrValidator{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 network in CIDR notation"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not a network in CIDR notation: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// ipv6CidrValidator checks that a string is an IPv6 network in CIDR
+// notation, e.g. "2001:db8:0:1::/64".
+type ipv6CidrValidator struct{}
+
+var _ validator.String = ipv6CidrValidator{}
+
+func (v ipv6CidrValidator) Description(ctx context.Context) string {
+	return "value must be an IPv6 network in CIDR notation"
+}
+
+func (v ipv6CidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv6CidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not a network in CIDR notation: %s", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 CIDR",
+			fmt.Sprintf("%q is not an IPv6 network.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// ipv4AddressValidator checks that a string is a single IPv4 address.
+type ipv4AddressValidator struct{}
+
+var _ validator.String = ipv4AddressValidator{}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if ip := net.ParseIP(req.ConfigValue.ValueString()); ip == nil || ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address",
+			fmt.Sprintf("%q is not an IPv4 address.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// ipAddressValidator checks that a string is a single IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+var _ validator.String = ipAddressValidator{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(req.ConfigValue.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not an IPv4 or IPv6 address.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// hostnameLabelPattern matches a single DNS label: letters, digits and
+// hyphens, not starting or ending with a hyphen.
+var hostnameLabelPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
+// hostnameValidator checks that a string is a fully qualified host name, such
+// as "mail.example.com". A single trailing dot is allowed.
+type hostnameValidator struct{}
+
+var _ validator.String = hostnameValidator{}
+
+func (v hostnameValidator) Description(ctx context.Context) string {
+	return "value must be a fully qualified host name"
+}
+
+func (v hostnameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostnameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkHostname(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Host Name", err.Error())
+	}
+}
+
+func checkHostname(hostname string) error {
+	name := strings.TrimSuffix(hostname, ".")
+	if len(name) > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", hostname)
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("%q is not fully qualified; expected a name such as mail.example.com", hostname)
+	}
+	for _, label := range labels {
+		if !hostnameLabelPattern.MatchString(label) {
+			return fmt.Errorf("%q contains an invalid label %q; labels are 1-63 letters, digits or hyphens and cannot start or end with a hyphen", hostname, label)
+		}
+	}
+	return nil
+}
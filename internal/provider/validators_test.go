@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateString runs v against value and reports whether it passed.
+func validateString(v validator.String, value types.String) bool {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: value}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), req, resp)
+	return !resp.Diagnostics.HasError()
+}
+
+func TestIPv4AddressValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		want  bool
+	}{
+		{"address", types.StringValue("203.0.113.25"), true},
+		{"ipv4-mapped ipv6", types.StringValue("::ffff:203.0.113.25"), true},
+		{"ipv6", types.StringValue("2001:db8::1"), false},
+		{"cidr", types.StringValue("203.0.113.0/24"), false},
+		{"out of range", types.StringValue("203.0.113.256"), false},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), true},
+		{"unknown", types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateString(ipv4AddressValidator{}, tt.value); got != tt.want {
+				t.Errorf("validating %s: passed = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCidrValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		want  bool
+	}{
+		{"ipv4 network", types.StringValue("203.0.113.0/24"), true},
+		{"ipv4 host route", types.StringValue("203.0.113.25/32"), true},
+		{"ipv4 any", types.StringValue("0.0.0.0/0"), true},
+		{"ipv6 network", types.StringValue("2001:db8::/32"), true},
+		{"bare address", types.StringValue("203.0.113.25"), false},
+		{"prefix too long", types.StringValue("203.0.113.0/33"), false},
+		{"hostname", types.StringValue("example.com/24"), false},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), true},
+		{"unknown", types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateString(cidrValidator{}, tt.value); got != tt.want {
+				t.Errorf("validating %s: passed = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPv6CidrValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		want  bool
+	}{
+		{"ipv6 network", types.StringValue("2001:db8:0:1::/64"), true},
+		{"ipv6 host route", types.StringValue("2001:db8::1/128"), true},
+		{"ipv4 network", types.StringValue("10.0.0.0/24"), false},
+		{"ipv4-mapped ipv6", types.StringValue("::ffff:10.0.0.0/120"), false},
+		{"bare address", types.StringValue("2001:db8::1"), false},
+		{"prefix too long", types.StringValue("2001:db8::/129"), false},
+		{"empty", types.StringValue(""), false},
+		{"null", types.StringNull(), true},
+		{"unknown", types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateString(ipv6CidrValidator{}, tt.value); got != tt.want {
+				t.Errorf("validating %s: passed = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		wantErr  bool
+	}{
+		{"fully qualified", "mail.example.com", false},
+		{"trailing dot", "mail.example.com.", false},
+		{"digits and hyphens", "node-01.example.com", false},
+		{"upper case", "Mail.Example.COM", false},
+		{"longest label", strings.Repeat("a", 63) + ".example.com", false},
+		{"single label", "localhost", true},
+		{"single label with dot", "localhost.", true},
+		{"label too long", strings.Repeat("a", 64) + ".example.com", true},
+		{"name too long", strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com", true},
+		{"leading hyphen", "-mail.example.com", true},
+		{"trailing hyphen", "mail-.example.com", true},
+		{"empty label", "mail..example.com", true},
+		{"underscore", "mail_server.example.com", true},
+		{"two trailing dots", "mail.example.com..", true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkHostname(tt.hostname)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkHostname(%q) error = %v, wantErr %v", tt.hostname, err, tt.wantErr)
+			}
+		})
+	}
+}
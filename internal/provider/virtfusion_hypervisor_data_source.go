@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionHypervisorDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &VirtfusionHypervisorDataSource{}
+
+func NewVirtfusionHypervisorDataSource() datasource.DataSource {
+	return &VirtfusionHypervisorDataSource{}
+}
+
+type VirtfusionHypervisorDataSource struct {
+	config *ProviderConfig
+}
+
+func (d *VirtfusionHypervisorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_hypervisor"
+}
+
+func (d *VirtfusionHypervisorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a hypervisor by ID or name, including its free capacity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Hypervisor ID. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor hostname.",
+				Computed:            true,
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor IP address.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the hypervisor accepts new servers.",
+				Computed:            true,
+			},
+			"maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether the hypervisor is in maintenance mode.",
+				Computed:            true,
+			},
+			"hypervisor_group_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the hypervisor group the hypervisor belongs to.",
+				Computed:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Name of the hypervisor group the hypervisor belongs to.",
+				Computed:            true,
+			},
+			"free_memory": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated memory in MB.",
+				Computed:            true,
+			},
+			"free_cpu_cores": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated CPU cores.",
+				Computed:            true,
+			},
+			"free_storage": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated local storage in GB.",
+				Computed:            true,
+			},
+			"server_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of servers on the hypervisor.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VirtfusionHypervisorDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *VirtfusionHypervisorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionHypervisorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data hypervisorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisors, err := d.config.hypervisors()
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []hypervisor
+	for _, hv := range hypervisors {
+		if !data.ID.IsNull() && hv.ID == data.ID.ValueInt64() {
+			matches = append(matches, hv)
+		}
+		if !data.Name.IsNull() && hv.Name == data.Name.ValueString() {
+			matches = append(matches, hv)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Hypervisor Not Found", "No hypervisor matches the given ID or name.")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Hypervisors Found",
+			fmt.Sprintf("%d hypervisors are named %q; look the hypervisor up by ID instead.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	hv := matches[0]
+	groupResources, err := d.config.hypervisorGroupResources(hv.Group.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Capacity Lookup Failed", err.Error())
+		return
+	}
+
+	var res hypervisorResources
+	for _, r := range groupResources {
+		if r.ID == hv.ID {
+			res = r
+		}
+	}
+
+	data = newHypervisorModel(hv, res)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionHypervisorGroupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &VirtfusionHypervisorGroupDataSource{}
+
+func NewVirtfusionHypervisorGroupDataSource() datasource.DataSource {
+	return &VirtfusionHypervisorGroupDataSource{}
+}
+
+type VirtfusionHypervisorGroupDataSource struct {
+	config *ProviderConfig
+}
+
+func (d *VirtfusionHypervisorGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_hypervisor_group"
+}
+
+func (d *VirtfusionHypervisorGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a hypervisor group (location) by ID or name, including its free capacity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Hypervisor group ID. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor group name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor group description.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the group accepts new servers.",
+				Computed:            true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the panel's default group.",
+				Computed:            true,
+			},
+			"hypervisor_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of hypervisors in the group.",
+				Computed:            true,
+			},
+			"available_hypervisors": schema.Int64Attribute{
+				MarkdownDescription: "Number of hypervisors in the group that are enabled and not in maintenance.",
+				Computed:            true,
+			},
+			"free_memory": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated memory in MB across available hypervisors.",
+				Computed:            true,
+			},
+			"free_cpu_cores": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated CPU cores across available hypervisors.",
+				Computed:            true,
+			},
+			"free_storage": schema.Int64Attribute{
+				MarkdownDescription: "Unallocated local storage in GB across available hypervisors.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VirtfusionHypervisorGroupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *VirtfusionHypervisorGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionHypervisorGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data hypervisorGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.config.hypervisorGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Group Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []hypervisorGroup
+	for _, group := range groups {
+		if !data.ID.IsNull() && group.ID == data.ID.ValueInt64() {
+			matches = append(matches, group)
+		}
+		if !data.Name.IsNull() && group.Name == data.Name.ValueString() {
+			matches = append(matches, group)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Hypervisor Group Not Found", "No hypervisor group matches the given ID or name.")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Hypervisor Groups Found",
+			fmt.Sprintf("%d hypervisor groups are named %q; look the group up by ID instead.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	data, err = newHypervisorGroupModel(d.config, matches[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Capacity Lookup Failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionHypervisorGroupsDataSource{}
+
+func NewVirtfusionHypervisorGroupsDataSource() datasource.DataSource {
+	return &VirtfusionHypervisorGroupsDataSource{}
+}
+
+type VirtfusionHypervisorGroupsDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionHypervisorGroupsDataSourceModel struct {
+	NameRegex        types.String           `tfsdk:"name_regex"`
+	EnabledOnly      types.Bool             `tfsdk:"enabled_only"`
+	HypervisorGroups []hypervisorGroupModel `tfsdk:"hypervisor_groups"`
+}
+
+type hypervisorGroupModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Default              types.Bool   `tfsdk:"default"`
+	HypervisorCount      types.Int64  `tfsdk:"hypervisor_count"`
+	AvailableHypervisors types.Int64  `tfsdk:"available_hypervisors"`
+	FreeMemory           types.Int64  `tfsdk:"free_memory"`
+	FreeCPUCores         types.Int64  `tfsdk:"free_cpu_cores"`
+	FreeStorage          types.Int64  `tfsdk:"free_storage"`
+}
+
+// newHypervisorGroupModel builds the Terraform representation of a group. Free
+// capacity only counts hypervisors that are enabled and not in maintenance,
+// since no other hypervisor will accept new servers.
+func newHypervisorGroupModel(config *ProviderConfig, group hypervisorGroup) (hypervisorGroupModel, error) {
+	available, count, err := availableHypervisorResources(config, group.ID)
+	if err != nil {
+		return hypervisorGroupModel{}, err
+	}
+
+	var free hypervisorResources
+	for _, res := range available {
+		free.Memory.Free += res.Memory.Free
+		free.CPUCores.Free += res.CPUCores.Free
+		free.Storage.Free += res.Storage.Free
+	}
+
+	return hypervisorGroupModel{
+		ID:                   types.Int64Value(group.ID),
+		Name:                 types.StringValue(group.Name),
+		Description:          types.StringValue(group.Description),
+		Enabled:              types.BoolValue(group.Enabled),
+		Default:              types.BoolValue(group.Default),
+		HypervisorCount:      types.Int64Value(count),
+		AvailableHypervisors: types.Int64Value(int64(len(available))),
+		FreeMemory:           types.Int64Value(free.Memory.Free),
+		FreeCPUCores:         types.Int64Value(free.CPUCores.Free),
+		FreeStorage:          types.Int64Value(free.Storage.Free),
+	}, nil
+}
+
+// availableHypervisorResources returns the current capacity of each hypervisor
+// in the group that is enabled and not in maintenance, along with the number
+// of hypervisors in the group.
+func availableHypervisorResources(config *ProviderConfig, groupID int64) ([]hypervisorResources, int64, error) {
+	hypervisors, err := config.hypervisors()
+	if err != nil {
+		return nil, 0, err
+	}
+	groupResources, err := config.hypervisorGroupResources(groupID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	available := map[int64]bool{}
+	var count int64
+	for _, hv := range hypervisors {
+		if hv.Group.ID != groupID {
+			continue
+		}
+		count++
+		if hv.Enabled && !hv.Maintenance {
+			available[hv.ID] = true
+		}
+	}
+
+	var result []hypervisorResources
+	for _, res := range groupResources {
+		if available[res.ID] {
+			result = append(result, res)
+		}
+	}
+	return result, count, nil
+}
+
+func (d *VirtfusionHypervisorGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_hypervisor_groups"
+}
+
+func (d *VirtfusionHypervisorGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists hypervisor groups (locations) and their free capacity, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list groups whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"enabled_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list groups that are enabled.",
+				Optional:            true,
+			},
+			"hypervisor_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching hypervisor groups.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                    schema.Int64Attribute{Computed: true},
+						"name":                  schema.StringAttribute{Computed: true},
+						"description":           schema.StringAttribute{Computed: true},
+						"enabled":               schema.BoolAttribute{Computed: true},
+						"default":               schema.BoolAttribute{Computed: true},
+						"hypervisor_count":      schema.Int64Attribute{Computed: true},
+						"available_hypervisors": schema.Int64Attribute{Computed: true},
+						"free_memory":           schema.Int64Attribute{Computed: true},
+						"free_cpu_cores":        schema.Int64Attribute{Computed: true},
+						"free_storage":          schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionHypervisorGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionHypervisorGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionHypervisorGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	groups, err := d.config.hypervisorGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Group Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []hypervisorGroup
+	for _, group := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+			continue
+		}
+		if data.EnabledOnly.ValueBool() && !group.Enabled {
+			continue
+		}
+		matches = append(matches, group)
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	data.HypervisorGroups = []hypervisorGroupModel{}
+	for _, group := range matches {
+		model, err := newHypervisorGroupModel(d.config, group)
+		if err != nil {
+			resp.Diagnostics.AddError("Hypervisor Capacity Lookup Failed", err.Error())
+			return
+		}
+		data.HypervisorGroups = append(data.HypervisorGroups, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
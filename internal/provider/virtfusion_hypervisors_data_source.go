@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionHypervisorsDataSource{}
+
+func NewVirtfusionHypervisorsDataSource() datasource.DataSource {
+	return &VirtfusionHypervisorsDataSource{}
+}
+
+type VirtfusionHypervisorsDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionHypervisorsDataSourceModel struct {
+	HypervisorGroupID types.Int64       `tfsdk:"hypervisor_group_id"`
+	NameRegex         types.String      `tfsdk:"name_regex"`
+	Hypervisors       []hypervisorModel `tfsdk:"hypervisors"`
+}
+
+type hypervisorModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Hostname          types.String `tfsdk:"hostname"`
+	IP                types.String `tfsdk:"ip"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Maintenance       types.Bool   `tfsdk:"maintenance"`
+	HypervisorGroupID types.Int64  `tfsdk:"hypervisor_group_id"`
+	Location          types.String `tfsdk:"location"`
+	FreeMemory        types.Int64  `tfsdk:"free_memory"`
+	FreeCPUCores      types.Int64  `tfsdk:"free_cpu_cores"`
+	FreeStorage       types.Int64  `tfsdk:"free_storage"`
+	ServerCount       types.Int64  `tfsdk:"server_count"`
+}
+
+// newHypervisorModel converts an API hypervisor and its current capacity into
+// its Terraform representation. Capacity is reported as 0 when unknown.
+func newHypervisorModel(hv hypervisor, res hypervisorResources) hypervisorModel {
+	return hypervisorModel{
+		ID:                types.Int64Value(hv.ID),
+		Name:              types.StringValue(hv.Name),
+		Hostname:          types.StringValue(hv.Hostname),
+		IP:                types.StringValue(hv.IP),
+		Enabled:           types.BoolValue(hv.Enabled),
+		Maintenance:       types.BoolValue(hv.Maintenance),
+		HypervisorGroupID: types.Int64Value(hv.Group.ID),
+		Location:          types.StringValue(hv.Group.Name),
+		FreeMemory:        types.Int64Value(res.Memory.Free),
+		FreeCPUCores:      types.Int64Value(res.CPUCores.Free),
+		FreeStorage:       types.Int64Value(res.Storage.Free),
+		ServerCount:       types.Int64Value(res.Servers.Allocated),
+	}
+}
+
+func (d *VirtfusionHypervisorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_hypervisors"
+}
+
+func (d *VirtfusionHypervisorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists hypervisors and their free capacity, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"hypervisor_group_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list hypervisors in this hypervisor group.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list hypervisors whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"hypervisors": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching hypervisors.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.Int64Attribute{Computed: true},
+						"name":                schema.StringAttribute{Computed: true},
+						"hostname":            schema.StringAttribute{Computed: true},
+						"ip":                  schema.StringAttribute{Computed: true},
+						"enabled":             schema.BoolAttribute{Computed: true},
+						"maintenance":         schema.BoolAttribute{Computed: true},
+						"hypervisor_group_id": schema.Int64Attribute{Computed: true},
+						"location":            schema.StringAttribute{Computed: true},
+						"free_memory":         schema.Int64Attribute{Computed: true},
+						"free_cpu_cores":      schema.Int64Attribute{Computed: true},
+						"free_storage":        schema.Int64Attribute{Computed: true},
+						"server_count":        schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionHypervisorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionHypervisorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionHypervisorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	hypervisors, err := d.config.hypervisors()
+	if err != nil {
+		resp.Diagnostics.AddError("Hypervisor Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []hypervisor
+	for _, hv := range hypervisors {
+		if !data.HypervisorGroupID.IsNull() && hv.Group.ID != data.HypervisorGroupID.ValueInt64() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(hv.Name) {
+			continue
+		}
+		matches = append(matches, hv)
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	// Capacity is reported per group, so fetch each group only once
+	resources := map[int64]hypervisorResources{}
+	fetched := map[int64]bool{}
+	for _, hv := range matches {
+		if fetched[hv.Group.ID] {
+			continue
+		}
+		fetched[hv.Group.ID] = true
+
+		groupResources, err := d.config.hypervisorGroupResources(hv.Group.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Hypervisor Capacity Lookup Failed", err.Error())
+			return
+		}
+		for _, res := range groupResources {
+			resources[res.ID] = res
+		}
+	}
+
+	data.Hypervisors = []hypervisorModel{}
+	for _, hv := range matches {
+		data.Hypervisors = append(data.Hypervisors, newHypervisorModel(hv, resources[hv.ID]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionIPBlocksDataSource{}
+
+func NewVirtfusionIPBlocksDataSource() datasource.DataSource {
+	return &VirtfusionIPBlocksDataSource{}
+}
+
+type VirtfusionIPBlocksDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionIPBlocksDataSourceModel struct {
+	IPVersion         types.Int64    `tfsdk:"ip_version"`
+	Type              types.String   `tfsdk:"type"`
+	HypervisorGroupID types.Int64    `tfsdk:"hypervisor_group_id"`
+	NameRegex         types.String   `tfsdk:"name_regex"`
+	Blocks            []ipBlockModel `tfsdk:"blocks"`
+}
+
+type ipBlockModel struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	CIDR               types.String  `tfsdk:"cidr"`
+	Gateway            types.String  `tfsdk:"gateway"`
+	IPVersion          types.Int64   `tfsdk:"ip_version"`
+	Type               types.String  `tfsdk:"type"`
+	HypervisorGroupIDs []types.Int64 `tfsdk:"hypervisor_group_ids"`
+	Free               types.Int64   `tfsdk:"free"`
+}
+
+func (d *VirtfusionIPBlocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_ip_blocks"
+}
+
+func (d *VirtfusionIPBlocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists IP blocks, sorted by ID. Every filter that is set must match.",
+		Attributes: map[string]schema.Attribute{
+			"ip_version": schema.Int64Attribute{
+				MarkdownDescription: "Only list blocks of this IP version: `4` or `6`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(4, 6),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list `public` or `private` blocks.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private"),
+				},
+			},
+			"hypervisor_group_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list blocks assigned to this hypervisor group.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list blocks whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"blocks": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching IP blocks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.Int64Attribute{Computed: true},
+						"name":       schema.StringAttribute{Computed: true},
+						"cidr":       schema.StringAttribute{Computed: true},
+						"gateway":    schema.StringAttribute{Computed: true},
+						"ip_version": schema.Int64Attribute{Computed: true},
+						"type":       schema.StringAttribute{Computed: true},
+						"hypervisor_group_ids": schema.ListAttribute{
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"free": schema.Int64Attribute{
+							MarkdownDescription: "Unallocated addresses (IPv4) or subnets (IPv6).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionIPBlocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionIPBlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionIPBlocksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	blocks, err := fetchIPBlocks(d.config)
+	if err != nil {
+		resp.Diagnostics.AddError("IP Block Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []ipBlock
+	for _, b := range blocks {
+		if !data.IPVersion.IsNull() && b.IPVersion != data.IPVersion.ValueInt64() {
+			continue
+		}
+		if !data.Type.IsNull() && b.blockType() != data.Type.ValueString() {
+			continue
+		}
+		if !data.HypervisorGroupID.IsNull() && !b.inHypervisorGroup(data.HypervisorGroupID.ValueInt64()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(b.Name) {
+			continue
+		}
+		matches = append(matches, b)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	data.Blocks = []ipBlockModel{}
+	for _, b := range matches {
+		groupIDs := []types.Int64{}
+		for _, g := range b.HypervisorGroups {
+			groupIDs = append(groupIDs, types.Int64Value(g.ID))
+		}
+		data.Blocks = append(data.Blocks, ipBlockModel{
+			ID:                 types.Int64Value(b.ID),
+			Name:               types.StringValue(b.Name),
+			CIDR:               types.StringValue(formatSubnet(b.Subnet, b.Cidr)),
+			Gateway:            types.StringValue(b.Gateway),
+			IPVersion:          types.Int64Value(b.IPVersion),
+			Type:               types.StringValue(b.blockType()),
+			HypervisorGroupIDs: groupIDs,
+			Free:               types.Int64Value(b.Free),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
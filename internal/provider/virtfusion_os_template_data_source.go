@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionOsTemplateDataSource{}
+
+func NewVirtfusionOsTemplateDataSource() datasource.DataSource {
+	return &VirtfusionOsTemplateDataSource{}
+}
+
+type VirtfusionOsTemplateDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionOsTemplateDataSourceModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Distro         types.String `tfsdk:"distro"`
+	Version        types.String `tfsdk:"version"`
+	Variant        types.String `tfsdk:"variant"`
+	Architecture   types.String `tfsdk:"architecture"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	DeploymentType types.Int64  `tfsdk:"deployment_type"`
+}
+
+func (d *VirtfusionOsTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_os_template"
+}
+
+func (d *VirtfusionOsTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single OS template. Every filter that is set must match, and exactly one template must remain.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "Only consider templates available to this server.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "OS template ID, usable as `osid` on `virtfusion_build`.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Template name, either bare (`Ubuntu Server`) or including version and variant (`Ubuntu Server 22.04`), matched case-insensitively.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"distro": schema.StringAttribute{
+				MarkdownDescription: "Distribution the template belongs to.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Template version.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Template variant.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "CPU architecture (`x86_64` or `aarch64`).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Template description.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Template type.",
+				Computed:            true,
+			},
+			"deployment_type": schema.Int64Attribute{
+				MarkdownDescription: "Deployment type of the template.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VirtfusionOsTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionOsTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionOsTemplateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var templates []osTemplate
+	var err error
+	if data.ServerID.IsNull() {
+		templates, err = d.config.osTemplates()
+	} else {
+		templates, err = d.config.serverOsTemplates(data.ServerID.ValueInt64())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("OS Template Lookup Failed", err.Error())
+		return
+	}
+
+	filter := osTemplateFilter{
+		Name:         data.Name.ValueString(),
+		Distro:       data.Distro.ValueString(),
+		Version:      data.Version.ValueString(),
+		Variant:      data.Variant.ValueString(),
+		Architecture: data.Architecture.ValueString(),
+	}
+
+	var matches []osTemplate
+	for _, tpl := range templates {
+		if filter.matches(tpl) {
+			matches = append(matches, tpl)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("OS Template Not Found", "No OS template matches the given filters.")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple OS Templates Found",
+			fmt.Sprintf("%d OS templates match the given filters; narrow the search with distro, version, variant or architecture.", len(matches)),
+		)
+		return
+	}
+
+	// Filters that were configured keep their configured spelling; the name
+	// may be given in any of the forms accepted by osTemplate.matches
+	tpl := matches[0]
+	data.ID = types.Int64Value(tpl.ID)
+	data.Name = stringOrDefault(data.Name, tpl.Name)
+	data.Distro = stringOrDefault(data.Distro, tpl.Distro)
+	data.Version = stringOrDefault(data.Version, tpl.Version)
+	data.Variant = stringOrDefault(data.Variant, tpl.Variant)
+	data.Architecture = stringOrDefault(data.Architecture, tpl.architecture())
+	data.Description = types.StringValue(tpl.Description)
+	data.Type = types.StringValue(tpl.Type)
+	data.DeploymentType = types.Int64Value(tpl.DeployType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// osTemplateFilter selects OS templates by their attributes. Empty fields match
+// any template; string comparisons are case-insensitive.
+type osTemplateFilter struct {
+	Name         string
+	Distro       string
+	Version      string
+	Variant      string
+	Architecture string
+}
+
+func (f osTemplateFilter) matches(tpl osTemplate) bool {
+	if f.Name != "" && !tpl.matches(f.Name) {
+		return false
+	}
+	if f.Distro != "" && !strings.EqualFold(tpl.Distro, f.Distro) {
+		return false
+	}
+	if f.Version != "" && !strings.EqualFold(tpl.Version, f.Version) {
+		return false
+	}
+	if f.Variant != "" && !strings.EqualFold(tpl.Variant, f.Variant) {
+		return false
+	}
+	if f.Architecture != "" && !strings.EqualFold(tpl.architecture(), f.Architecture) {
+		return false
+	}
+	return true
+}
+
+// stringOrDefault returns the configured value if set, or fallback otherwise.
+func stringOrDefault(configured types.String, fallback string) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.StringValue(fallback)
+	}
+	return configured
+}
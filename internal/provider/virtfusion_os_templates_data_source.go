@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionOsTemplatesDataSource{}
+
+func NewVirtfusionOsTemplatesDataSource() datasource.DataSource {
+	return &VirtfusionOsTemplatesDataSource{}
+}
+
+type VirtfusionOsTemplatesDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionOsTemplatesDataSourceModel struct {
+	ServerID  types.Int64       `tfsdk:"server_id"`
+	NameRegex types.String      `tfsdk:"name_regex"`
+	Templates []osTemplateModel `tfsdk:"templates"`
+}
+
+type osTemplateModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Distro         types.String `tfsdk:"distro"`
+	Version        types.String `tfsdk:"version"`
+	Variant        types.String `tfsdk:"variant"`
+	Architecture   types.String `tfsdk:"architecture"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	DeploymentType types.Int64  `tfsdk:"deployment_type"`
+}
+
+func (d *VirtfusionOsTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_os_templates"
+}
+
+func (d *VirtfusionOsTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists OS templates, sorted by name, version, variant and ID.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list templates available to this server.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list templates whose full name (`name version variant`) matches this regular expression.",
+				Optional:            true,
+			},
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching OS templates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":              schema.Int64Attribute{Computed: true},
+						"name":            schema.StringAttribute{Computed: true},
+						"distro":          schema.StringAttribute{Computed: true},
+						"version":         schema.StringAttribute{Computed: true},
+						"variant":         schema.StringAttribute{Computed: true},
+						"architecture":    schema.StringAttribute{Computed: true},
+						"description":     schema.StringAttribute{Computed: true},
+						"type":            schema.StringAttribute{Computed: true},
+						"deployment_type": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionOsTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionOsTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionOsTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	var templates []osTemplate
+	var err error
+	if data.ServerID.IsNull() {
+		templates, err = d.config.osTemplates()
+	} else {
+		templates, err = d.config.serverOsTemplates(data.ServerID.ValueInt64())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("OS Template Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []osTemplate
+	for _, tpl := range templates {
+		if nameRegex == nil || nameRegex.MatchString(tpl.fullName()) {
+			matches = append(matches, tpl)
+		}
+	}
+
+	// Keep the ordering stable regardless of the order the API returns
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		if a.Variant != b.Variant {
+			return a.Variant < b.Variant
+		}
+		return a.ID < b.ID
+	})
+
+	data.Templates = []osTemplateModel{}
+	for _, tpl := range matches {
+		data.Templates = append(data.Templates, osTemplateModel{
+			ID:             types.Int64Value(tpl.ID),
+			Name:           types.StringValue(tpl.Name),
+			Distro:         types.StringValue(tpl.Distro),
+			Version:        types.StringValue(tpl.Version),
+			Variant:        types.StringValue(tpl.Variant),
+			Architecture:   types.StringValue(tpl.architecture()),
+			Description:    types.StringValue(tpl.Description),
+			Type:           types.StringValue(tpl.Type),
+			DeploymentType: types.Int64Value(tpl.DeployType),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionPackageDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &VirtfusionPackageDataSource{}
+
+func NewVirtfusionPackageDataSource() datasource.DataSource {
+	return &VirtfusionPackageDataSource{}
+}
+
+type VirtfusionPackageDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionPackageDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Memory        types.Int64  `tfsdk:"memory"`
+	Cores         types.Int64  `tfsdk:"cores"`
+	Storage       types.Int64  `tfsdk:"storage"`
+	Traffic       types.Int64  `tfsdk:"traffic"`
+	InboundSpeed  types.Int64  `tfsdk:"inbound_network_speed"`
+	OutboundSpeed types.Int64  `tfsdk:"outbound_network_speed"`
+}
+
+func (d *VirtfusionPackageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_package"
+}
+
+func (d *VirtfusionPackageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a server package by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Package ID. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Package name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Package description.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the package can be used for new servers.",
+				Computed:            true,
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "Memory in MB.",
+				Computed:            true,
+			},
+			"cores": schema.Int64Attribute{
+				MarkdownDescription: "CPU cores.",
+				Computed:            true,
+			},
+			"storage": schema.Int64Attribute{
+				MarkdownDescription: "Primary storage in GB.",
+				Computed:            true,
+			},
+			"traffic": schema.Int64Attribute{
+				MarkdownDescription: "Traffic allowance in GB. 0=Unlimited",
+				Computed:            true,
+			},
+			"inbound_network_speed": schema.Int64Attribute{
+				MarkdownDescription: "Inbound network speed in kB/s.",
+				Computed:            true,
+			},
+			"outbound_network_speed": schema.Int64Attribute{
+				MarkdownDescription: "Outbound network speed in kB/s.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VirtfusionPackageDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *VirtfusionPackageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionPackageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionPackageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packages, err := d.config.packages()
+	if err != nil {
+		resp.Diagnostics.AddError("Package Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []resourcePackage
+	for _, pkg := range packages {
+		if !data.ID.IsNull() && pkg.ID == data.ID.ValueInt64() {
+			matches = append(matches, pkg)
+		}
+		if !data.Name.IsNull() && pkg.Name == data.Name.ValueString() {
+			matches = append(matches, pkg)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Package Not Found", "No package matches the given ID or name.")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Packages Found",
+			fmt.Sprintf("%d packages are named %q; look the package up by ID instead.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	pkg := matches[0]
+	data.ID = types.Int64Value(pkg.ID)
+	data.Name = types.StringValue(pkg.Name)
+	data.Description = types.StringValue(pkg.Description)
+	data.Enabled = types.BoolValue(pkg.Enabled)
+	data.Memory = types.Int64Value(pkg.Memory)
+	data.Cores = types.Int64Value(pkg.CPUCores)
+	data.Storage = types.Int64Value(pkg.PrimaryStorage)
+	data.Traffic = types.Int64Value(pkg.Traffic)
+	data.InboundSpeed = types.Int64Value(pkg.NetworkSpeedInbound)
+	data.OutboundSpeed = types.Int64Value(pkg.NetworkSpeedOutbound)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionPackagesDataSource{}
+
+func NewVirtfusionPackagesDataSource() datasource.DataSource {
+	return &VirtfusionPackagesDataSource{}
+}
+
+type VirtfusionPackagesDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionPackagesDataSourceModel struct {
+	NameRegex   types.String   `tfsdk:"name_regex"`
+	EnabledOnly types.Bool     `tfsdk:"enabled_only"`
+	Packages    []packageModel `tfsdk:"packages"`
+}
+
+type packageModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Memory        types.Int64  `tfsdk:"memory"`
+	Cores         types.Int64  `tfsdk:"cores"`
+	Storage       types.Int64  `tfsdk:"storage"`
+	Traffic       types.Int64  `tfsdk:"traffic"`
+	InboundSpeed  types.Int64  `tfsdk:"inbound_network_speed"`
+	OutboundSpeed types.Int64  `tfsdk:"outbound_network_speed"`
+}
+
+func (d *VirtfusionPackagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_packages"
+}
+
+func (d *VirtfusionPackagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists server packages, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list packages whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"enabled_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list packages that are enabled.",
+				Optional:            true,
+			},
+			"packages": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching packages.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                     schema.Int64Attribute{Computed: true},
+						"name":                   schema.StringAttribute{Computed: true},
+						"description":            schema.StringAttribute{Computed: true},
+						"enabled":                schema.BoolAttribute{Computed: true},
+						"memory":                 schema.Int64Attribute{Computed: true},
+						"cores":                  schema.Int64Attribute{Computed: true},
+						"storage":                schema.Int64Attribute{Computed: true},
+						"traffic":                schema.Int64Attribute{Computed: true},
+						"inbound_network_speed":  schema.Int64Attribute{Computed: true},
+						"outbound_network_speed": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionPackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionPackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionPackagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	packages, err := d.config.packages()
+	if err != nil {
+		resp.Diagnostics.AddError("Package Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []resourcePackage
+	for _, pkg := range packages {
+		if nameRegex != nil && !nameRegex.MatchString(pkg.Name) {
+			continue
+		}
+		if data.EnabledOnly.ValueBool() && !pkg.Enabled {
+			continue
+		}
+		matches = append(matches, pkg)
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	data.Packages = []packageModel{}
+	for _, pkg := range matches {
+		data.Packages = append(data.Packages, packageModel{
+			ID:            types.Int64Value(pkg.ID),
+			Name:          types.StringValue(pkg.Name),
+			Description:   types.StringValue(pkg.Description),
+			Enabled:       types.BoolValue(pkg.Enabled),
+			Memory:        types.Int64Value(pkg.Memory),
+			Cores:         types.Int64Value(pkg.CPUCores),
+			Storage:       types.Int64Value(pkg.PrimaryStorage),
+			Traffic:       types.Int64Value(pkg.Traffic),
+			InboundSpeed:  types.Int64Value(pkg.NetworkSpeedInbound),
+			OutboundSpeed: types.Int64Value(pkg.NetworkSpeedOutbound),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionReverseDNSResource{}
+var _ resource.ResourceWithImportState = &VirtfusionReverseDNSResource{}
+
+func NewVirtfusionReverseDNSResource() resource.Resource {
+	return &VirtfusionReverseDNSResource{}
+}
+
+type VirtfusionReverseDNSResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionReverseDNSResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Address  types.String `tfsdk:"address"`
+	Hostname types.String `tfsdk:"hostname"`
+}
+
+// apiReverseDNS is the PTR record of a server address.
+type apiReverseDNS struct {
+	Address  string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
+
+func (r *VirtfusionReverseDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_reverse_dns"
+}
+
+func (r *VirtfusionReverseDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the reverse DNS (PTR) record of an address assigned to a server. Destroying the resource clears the record.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`<server_id>/<address>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server the address is assigned to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address to set the record for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified host name the address resolves to, e.g. `mail.example.com`.",
+				Required:            true,
+				Validators: []validator.String{
+					hostnameValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionReverseDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionReverseDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionReverseDNSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setHostname(data.ServerID.ValueInt64(), data.Address.ValueString(), data.Hostname.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Reverse DNS Update Failed", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/" + data.Address.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionReverseDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionReverseDNSResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.reverseDNSURL(data.ServerID.ValueInt64(), data.Address.ValueString())
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiReverseDNS `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	if respData.Data.Hostname == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// DNS names are case-insensitive and the trailing dot is optional, so
+	// keep the configured spelling unless the record really changed
+	if !sameHostname(respData.Data.Hostname, data.Hostname.ValueString()) {
+		data.Hostname = types.StringValue(respData.Data.Hostname)
+	}
+	data.ID = types.StringValue(strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/" + data.Address.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionReverseDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionReverseDNSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setHostname(data.ServerID.ValueInt64(), data.Address.ValueString(), data.Hostname.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Reverse DNS Update Failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionReverseDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionReverseDNSResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.reverseDNSURL(data.ServerID.ValueInt64(), data.Address.ValueString())
+	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+}
+
+func (r *VirtfusionReverseDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverPart, address, ok := strings.Cut(req.ID, "/")
+	serverID, err := strconv.ParseInt(serverPart, 10, 64)
+	if !ok || err != nil || address == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected <server_id>/<address>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), address)...)
+}
+
+func (r *VirtfusionReverseDNSResource) reverseDNSURL(serverID int64, address string) string {
+	return r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/rdns/" + url.PathEscape(address)
+}
+
+// setHostname creates or replaces the PTR record of the address.
+func (r *VirtfusionReverseDNSResource) setHostname(serverID int64, address, hostname string) error {
+	body, _ := json.Marshal(map[string]interface{}{
+		"hostname": hostname,
+	})
+	httpReq, err := http.NewRequest("PUT", r.reverseDNSURL(serverID, address), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while setting reverse DNS for %s", httpResp.StatusCode, address)
+	}
+	return nil
+}
+
+// sameHostname compares host names case-insensitively, ignoring a trailing
+// dot.
+func sameHostname(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerBackupResource{}
+
+func NewVirtfusionServerBackupResource() resource.Resource {
+	return &VirtfusionServerBackupResource{}
+}
+
+type VirtfusionServerBackupResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerBackupResourceModel struct {
+	ID          types.Int64    `tfsdk:"id"`
+	ServerID    types.Int64    `tfsdk:"server_id"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	Status      types.String   `tfsdk:"status"`
+	Size        types.Int64    `tfsdk:"size"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	CompletedAt types.String   `tfsdk:"completed_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Backup states reported by the API. Any other state means the backup is
+// still queued or running.
+const (
+	backupStatusComplete = "complete"
+	backupStatusFailed   = "failed"
+)
+
+// apiBackup is a server backup as returned by the VirtFusion API.
+type apiBackup struct {
+	ID          int64  `json:"id"`
+	ServerID    int64  `json:"serverId"`
+	Status      string `json:"status"`
+	Size        int64  `json:"size"`
+	CreatedAt   string `json:"created"`
+	CompletedAt string `json:"completed"`
+}
+
+func (r *VirtfusionServerBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_backup"
+}
+
+func (r *VirtfusionServerBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes an on-demand backup of a server and waits for it to complete. Destroying the resource deletes the backup. A backup that fails or does not finish in time stays in state as tainted, so the next apply deletes it before taking a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server to back up.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, take a new backup.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Backup status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Backup size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the backup was started.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "When the backup completed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *VirtfusionServerBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/backups"
+
+	httpReq, err := http.NewRequest("POST", reqURL, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 && httpResp.StatusCode != 202 {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Status: %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	var respData struct {
+		Data apiBackup `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	// Track the backup before waiting for it, so one that times out or fails
+	// is tainted and deleted by the next apply instead of being orphaned
+	backup := respData.Data
+	data.setFromAPI(backup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err = waitFor(waitCtx, func() (bool, error) {
+		if backup.Status == backupStatusComplete || backup.Status == backupStatusFailed {
+			return true, nil
+		}
+		current, err := fetchServerBackup(r.config, data.ServerID.ValueInt64(), backup.ID)
+		if err != nil {
+			return false, err
+		}
+		backup = current
+		return false, nil
+	})
+
+	data.setFromAPI(backup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if stoppedWaiting(err) {
+		resp.Diagnostics.AddError("Backup Did Not Complete", fmt.Sprintf("Backup %d had not finished when the create timeout expired.", backup.ID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Backup Status Lookup Failed", fmt.Sprintf("Could not check backup %d: %s", backup.ID, err))
+		return
+	}
+	if backup.Status == backupStatusFailed {
+		resp.Diagnostics.AddError("Backup Failed", fmt.Sprintf("Backup %d of server %d failed.", backup.ID, data.ServerID.ValueInt64()))
+		return
+	}
+}
+
+func (r *VirtfusionServerBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/backups/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiBackup `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.setFromAPI(respData.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only runs when timeouts change; every other argument forces a new
+// backup.
+func (r *VirtfusionServerBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionServerBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionServerBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/backups/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+}
+
+// setFromAPI copies the backup's current state into the model.
+func (m *VirtfusionServerBackupResourceModel) setFromAPI(b apiBackup) {
+	m.ID = types.Int64Value(b.ID)
+	m.Status = types.StringValue(b.Status)
+	m.Size = types.Int64Value(b.Size)
+	m.CreatedAt = types.StringValue(b.CreatedAt)
+	m.CompletedAt = types.StringValue(b.CompletedAt)
+}
+
+// fetchServerBackup returns a single backup of a server.
+func fetchServerBackup(config *ProviderConfig, serverID, backupID int64) (apiBackup, error) {
+	var respData struct {
+		Data apiBackup `json:"data"`
+	}
+	apiPath := "/servers/" + strconv.FormatInt(serverID, 10) + "/backups/" + strconv.FormatInt(backupID, 10)
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, apiPath, &respData); err != nil {
+		return apiBackup{}, err
+	}
+	return respData.Data, nil
+}
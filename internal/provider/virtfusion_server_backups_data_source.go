@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionServerBackupsDataSource{}
+
+func NewVirtfusionServerBackupsDataSource() datasource.DataSource {
+	return &VirtfusionServerBackupsDataSource{}
+}
+
+type VirtfusionServerBackupsDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionServerBackupsDataSourceModel struct {
+	ServerID types.Int64   `tfsdk:"server_id"`
+	Status   types.String  `tfsdk:"status"`
+	Backups  []backupModel `tfsdk:"backups"`
+}
+
+type backupModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	Size        types.Int64  `tfsdk:"size"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+}
+
+func (d *VirtfusionServerBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_backups"
+}
+
+func (d *VirtfusionServerBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists a server's backups, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "Server whose backups to list.",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list backups in this status, e.g. `complete`.",
+				Optional:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching backups.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.Int64Attribute{Computed: true},
+						"status":       schema.StringAttribute{Computed: true},
+						"size":         schema.Int64Attribute{Computed: true},
+						"created_at":   schema.StringAttribute{Computed: true},
+						"completed_at": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionServerBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionServerBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionServerBackupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := fetchServerBackups(d.config, data.ServerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Backup Lookup Failed", err.Error())
+		return
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].ID < backups[j].ID })
+
+	data.Backups = []backupModel{}
+	for _, b := range backups {
+		if !data.Status.IsNull() && b.Status != data.Status.ValueString() {
+			continue
+		}
+		data.Backups = append(data.Backups, backupModel{
+			ID:          types.Int64Value(b.ID),
+			Status:      types.StringValue(b.Status),
+			Size:        types.Int64Value(b.Size),
+			CreatedAt:   types.StringValue(b.CreatedAt),
+			CompletedAt: types.StringValue(b.CompletedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
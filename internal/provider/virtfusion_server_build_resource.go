@@ -8,13 +8,21 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
 )
 
 // Ensure implementation
 var _ resource.Resource = &VirtfusionServerBuildResource{}
+var _ resource.ResourceWithModifyPlan = &VirtfusionServerBuildResource{}
 
 func NewVirtfusionServerBuildResource() resource.Resource {
 	return &VirtfusionServerBuildResource{}
@@ -26,15 +34,17 @@ type VirtfusionServerBuildResource struct {
 }
 
 type VirtfusionServerBuildResourceModel struct {
-	ID       types.Int64   `tfsdk:"id"`
-	ServerID types.Int64   `tfsdk:"server_id"`
-	Name     types.String  `tfsdk:"name"`
-	Hostname types.String  `tfsdk:"hostname"`
-	OsID     types.Int64   `tfsdk:"osid"`
-	VNC      types.Bool    `tfsdk:"vnc"`
-	IPv6     types.Bool    `tfsdk:"ipv6"`
-	SSHKeys  []types.Int64 `tfsdk:"ssh_keys"`
-	Email    types.Bool    `tfsdk:"email"`
+	ID               types.Int64  `tfsdk:"id"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	Name             types.String `tfsdk:"name"`
+	Hostname         types.String `tfsdk:"hostname"`
+	OsID             types.Int64  `tfsdk:"osid"`
+	VNC              types.Bool   `tfsdk:"vnc"`
+	IPv6             types.Bool   `tfsdk:"ipv6"`
+	SSHKeys          types.List   `tfsdk:"ssh_keys"`
+	AuthorizedKeys   types.List   `tfsdk:"authorized_keys"`
+	AuthorizedKeyIDs types.List   `tfsdk:"authorized_key_ids"`
+	Email            types.Bool   `tfsdk:"email"`
 }
 
 func (r *VirtfusionServerBuildResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +79,20 @@ func (r *VirtfusionServerBuildResource) Schema(ctx context.Context, req resource
 				ElementType: types.Int64Type,
 				Optional:    true,
 			},
+			"authorized_keys": schema.ListAttribute{
+				ElementType: sshPublicKeyType{},
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(sshPublicKeyValidator{}),
+				},
+			},
+			"authorized_key_ids": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"email": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -94,6 +118,59 @@ func (r *VirtfusionServerBuildResource) Configure(ctx context.Context, req resou
 	r.config = config
 }
 
+// ModifyPlan checks the requested OS template against the templates available
+// to the server, so a template the build would reject fails at plan time.
+func (r *VirtfusionServerBuildResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config == nil {
+		return
+	}
+
+	var data VirtfusionServerBuildResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changed keys may map to different key IDs; the old ones are only kept
+	// while authorized_keys is unchanged
+	if !req.State.Raw.IsNull() {
+		var state VirtfusionServerBuildResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !data.AuthorizedKeys.Equal(state.AuthorizedKeys) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("authorized_key_ids"), types.ListUnknown(types.Int64Type))...)
+		}
+	}
+
+	// Nothing to validate until both the server and the template are known
+	if data.ServerID.IsUnknown() || data.ServerID.IsNull() || data.OsID.IsUnknown() || data.OsID.IsNull() {
+		return
+	}
+
+	templates, err := r.config.serverOsTemplates(data.ServerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate OS Template",
+			fmt.Sprintf("Could not fetch OS templates for server %d: %s", data.ServerID.ValueInt64(), err),
+		)
+		return
+	}
+
+	for _, tpl := range templates {
+		if tpl.ID == data.OsID.ValueInt64() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("osid"),
+		"OS Template Not Available",
+		fmt.Sprintf("OS template %d is not available to server %d.", data.OsID.ValueInt64(), data.ServerID.ValueInt64()),
+	)
+}
+
 func (r *VirtfusionServerBuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VirtfusionServerBuildResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -103,7 +180,7 @@ func (r *VirtfusionServerBuildResource) Create(ctx context.Context, req resource
 
 	// If no osid provided, try to resolve from provider default OsTemplate
 	if data.OsID.IsNull() && r.config.OsTemplate != "" {
-		osid, err := resolveOsTemplateToID(r.client, r.config.Endpoint, r.config.ApiToken, r.config.OsTemplate)
+		osid, err := resolveOsTemplateToID(r.config, data.ServerID.ValueInt64(), r.config.OsTemplate)
 		if err != nil {
 			resp.Diagnostics.AddError("OS Template Resolution Failed", err.Error())
 			return
@@ -111,6 +188,18 @@ func (r *VirtfusionServerBuildResource) Create(ctx context.Context, req resource
 		data.OsID = types.Int64Value(osid)
 	}
 
+	sshKeys, createdKeys, diags := r.resolveAuthorizedKeys(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	built := false
+	defer func() {
+		if !built {
+			r.removeSSHKeys(createdKeys, &resp.Diagnostics)
+		}
+	}()
+	if diags.HasError() {
+		return
+	}
+
 	payload := map[string]interface{}{
 		"server_id": data.ServerID.ValueInt64(),
 		"name":      data.Name.ValueString(),
@@ -118,7 +207,7 @@ func (r *VirtfusionServerBuildResource) Create(ctx context.Context, req resource
 		"osid":      data.OsID.ValueInt64(),
 		"vnc":       data.VNC.ValueBool(),
 		"ipv6":      data.IPv6.ValueBool(),
-		"ssh_keys":  flattenInt64List(data.SSHKeys),
+		"ssh_keys":  sshKeys,
 		"email":     data.Email.ValueBool(),
 	}
 
@@ -147,6 +236,7 @@ func (r *VirtfusionServerBuildResource) Create(ctx context.Context, req resource
 		)
 		return
 	}
+	built = true
 
 	var respData map[string]interface{}
 	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
@@ -198,6 +288,18 @@ func (r *VirtfusionServerBuildResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	sshKeys, createdKeys, diags := r.resolveAuthorizedKeys(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	updated := false
+	defer func() {
+		if !updated {
+			r.removeSSHKeys(createdKeys, &resp.Diagnostics)
+		}
+	}()
+	if diags.HasError() {
+		return
+	}
+
 	reqURL := r.config.Endpoint + "/api/v1/build/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
 	payload := map[string]interface{}{
 		"name":     data.Name.ValueString(),
@@ -205,7 +307,7 @@ func (r *VirtfusionServerBuildResource) Update(ctx context.Context, req resource
 		"osid":     data.OsID.ValueInt64(),
 		"vnc":      data.VNC.ValueBool(),
 		"ipv6":     data.IPv6.ValueBool(),
-		"ssh_keys": flattenInt64List(data.SSHKeys),
+		"ssh_keys": sshKeys,
 		"email":    data.Email.ValueBool(),
 	}
 
@@ -225,6 +327,7 @@ func (r *VirtfusionServerBuildResource) Update(ctx context.Context, req resource
 		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
 		return
 	}
+	updated = true
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -253,45 +356,102 @@ func (r *VirtfusionServerBuildResource) Delete(ctx context.Context, req resource
 	}
 }
 
-// resolveOsTemplateToID resolves a template name to its numeric ID via API
-func resolveOsTemplateToID(client *http.Client, endpoint, apiToken, templateName string) (int64, error) {
-	reqURL := endpoint + "/api/v1/os-templates"
-	httpReq, _ := http.NewRequest("GET", reqURL, nil)
-	httpReq.Header.Set("Authorization", "Bearer "+apiToken)
+// resolveAuthorizedKeys maps each authorized key to the ID of the matching key
+// on the server owner's account, creating keys that do not exist yet. It sets
+// authorized_key_ids and returns the combined, de-duplicated key IDs to send
+// with the build, along with the IDs of the keys it created. Created keys are
+// returned even on error so the caller can remove them.
+func (r *VirtfusionServerBuildResource) resolveAuthorizedKeys(ctx context.Context, data *VirtfusionServerBuildResourceModel) (keyIDs, created []int64, diags diag.Diagnostics) {
+	var authorizedKeys []string
+	diags.Append(data.SSHKeys.ElementsAs(ctx, &keyIDs, false)...)
+	diags.Append(data.AuthorizedKeys.ElementsAs(ctx, &authorizedKeys, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	data.AuthorizedKeyIDs = types.ListNull(types.Int64Type)
+	if data.AuthorizedKeys.IsNull() {
+		return keyIDs, nil, diags
+	}
 
-	httpResp, err := client.Do(httpReq)
+	authorizedIDs, created, err := r.authorizedKeyIDs(data.ServerID.ValueInt64(), authorizedKeys)
 	if err != nil {
-		return 0, err
+		diags.AddAttributeError(path.Root("authorized_keys"), "SSH Key Resolution Failed", err.Error())
+		return nil, created, diags
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != 200 {
-		return 0, fmt.Errorf("unexpected status %d while fetching OS templates", httpResp.StatusCode)
+	ids, listDiags := types.ListValueFrom(ctx, types.Int64Type, authorizedIDs)
+	diags.Append(listDiags...)
+	data.AuthorizedKeyIDs = ids
+
+	seen := map[int64]bool{}
+	for _, id := range keyIDs {
+		seen[id] = true
 	}
+	for _, id := range authorizedIDs {
+		if !seen[id] {
+			seen[id] = true
+			keyIDs = append(keyIDs, id)
+		}
+	}
+	return keyIDs, created, diags
+}
 
-	var respData []map[string]interface{}
-	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
-		return 0, err
+// authorizedKeyIDs returns the key ID for each authorized key, in order, and
+// the IDs of the keys it had to create.
+func (r *VirtfusionServerBuildResource) authorizedKeyIDs(serverID int64, authorizedKeys []string) (ids, created []int64, err error) {
+	server, err := fetchServer(r.config, serverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing, err := fetchSSHKeys(r.config, server.OwnerID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, tpl := range respData {
-		if tpl["name"] == templateName {
-			if id, ok := tpl["id"].(float64); ok {
-				return int64(id), nil
-			}
+	// Keys are matched on key material so comments and whitespace don't matter
+	known := map[string]int64{}
+	for _, key := range existing {
+		if parsed, err := parseSSHPublicKey(key.PublicKey); err == nil {
+			known[string(parsed.Marshal())] = key.ID
 		}
 	}
 
-	return 0, fmt.Errorf("OS template %q not found", templateName)
+	ids = []int64{}
+	for _, value := range authorizedKeys {
+		parsed, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(value))
+		if err != nil {
+			return nil, created, err
+		}
+
+		id, ok := known[string(parsed.Marshal())]
+		if !ok {
+			name := comment
+			if name == "" {
+				name = ssh.FingerprintSHA256(parsed)
+			}
+			id, err = createSSHKey(r.config, server.OwnerID, name, string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(parsed))))
+			if err != nil {
+				return nil, created, err
+			}
+			created = append(created, id)
+			known[string(parsed.Marshal())] = id
+		}
+
+		ids = append(ids, id)
+	}
+	return ids, created, nil
 }
 
-// helper to convert []types.Int64 → []int64
-func flattenInt64List(list []types.Int64) []int64 {
-	var result []int64
-	for _, v := range list {
-		if !v.IsNull() {
-			result = append(result, v.ValueInt64())
+// removeSSHKeys deletes keys that resolveAuthorizedKeys created for a build
+// that then failed, so they are not left untracked on the user's account.
+func (r *VirtfusionServerBuildResource) removeSSHKeys(keyIDs []int64, diags *diag.Diagnostics) {
+	for _, id := range keyIDs {
+		if err := deleteSSHKey(r.config, id); err != nil {
+			diags.AddWarning(
+				"SSH Key Cleanup Failed",
+				fmt.Sprintf("SSH key %d was created for this build but could not be removed: %s", id, err),
+			)
 		}
 	}
-	return result
 }
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestPlan returns a plan for the resource's schema in which every
+// attribute is unknown unless it is set in known.
+func newTestPlan(t *testing.T, r resource.Resource, known map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := known[name]; ok {
+			values[name] = value
+			continue
+		}
+		values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+	}
+
+	return tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func TestServerBuildPlanGet(t *testing.T) {
+	keyList := tftypes.List{ElementType: tftypes.String}
+	idList := tftypes.List{ElementType: tftypes.Number}
+
+	tests := []struct {
+		name  string
+		known map[string]tftypes.Value
+	}{
+		{
+			name: "everything unknown",
+		},
+		{
+			name: "configured keys with unknown key ids",
+			known: map[string]tftypes.Value{
+				"server_id": tftypes.NewValue(tftypes.Number, 42),
+				"ssh_keys":  tftypes.NewValue(idList, []tftypes.Value{tftypes.NewValue(tftypes.Number, 7)}),
+				"authorized_keys": tftypes.NewValue(keyList, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDHoPNhnjSCTsVDz1Z6eP8KjM6zQ3VmW3QOEf3VFzpRf user@host"),
+				}),
+			},
+		},
+		{
+			name: "unknown key lists",
+			known: map[string]tftypes.Value{
+				"server_id": tftypes.NewValue(tftypes.Number, 42),
+				"email":     tftypes.NewValue(tftypes.Bool, nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := newTestPlan(t, NewVirtfusionServerBuildResource(), tt.known)
+			var data VirtfusionServerBuildResourceModel
+			if diags := plan.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("Plan.Get: %v", diags)
+			}
+		})
+	}
+}
+
+func TestResolveAuthorizedKeysWithoutAuthorizedKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		sshKeys types.List
+		want    []int64
+	}{
+		{"no keys", types.ListNull(types.Int64Type), nil},
+		{"key ids only", types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(7), types.Int64Value(9)}), []int64{7, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := VirtfusionServerBuildResourceModel{
+				SSHKeys:        tt.sshKeys,
+				AuthorizedKeys: types.ListNull(sshPublicKeyType{}),
+			}
+			r := &VirtfusionServerBuildResource{}
+			got, created, diags := r.resolveAuthorizedKeys(context.Background(), &data)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) || created != nil {
+				t.Errorf("got %v, created %v, want %v", got, created, tt.want)
+			}
+			if !data.AuthorizedKeyIDs.IsNull() {
+				t.Errorf("authorized_key_ids = %v, want null", data.AuthorizedKeyIDs)
+			}
+		})
+	}
+}
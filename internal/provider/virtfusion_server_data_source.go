@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionServerDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &VirtfusionServerDataSource{}
+
+func NewVirtfusionServerDataSource() datasource.DataSource {
+	return &VirtfusionServerDataSource{}
+}
+
+type VirtfusionServerDataSource struct {
+	config *ProviderConfig
+}
+
+// VirtfusionServerDataSourceModel extends the server settings with the
+// read-only details only the API knows about.
+type VirtfusionServerDataSourceModel struct {
+	serverSpecModel
+	Name          types.String   `tfsdk:"name"`
+	Hostname      types.String   `tfsdk:"hostname"`
+	UUID          types.String   `tfsdk:"uuid"`
+	State         types.String   `tfsdk:"state"`
+	PowerState    types.String   `tfsdk:"power_state"`
+	Suspended     types.Bool     `tfsdk:"suspended"`
+	OsName        types.String   `tfsdk:"os_name"`
+	PrimaryIPv4   types.String   `tfsdk:"primary_ipv4"`
+	IPv4Addresses []types.String `tfsdk:"ipv4_addresses"`
+	IPv6Subnets   []types.String `tfsdk:"ipv6_subnets"`
+	Tags          []types.String `tfsdk:"tags"`
+}
+
+// newServerDataSourceModel converts an API server into its data source representation.
+func newServerDataSourceModel(s apiServer) VirtfusionServerDataSourceModel {
+	var data VirtfusionServerDataSourceModel
+	data.setFromAPI(s)
+	data.Name = types.StringValue(s.Name)
+	data.Hostname = types.StringValue(s.Hostname)
+	data.UUID = types.StringValue(s.UUID)
+	data.State = types.StringValue(s.State)
+	data.PowerState = types.StringValue(s.RemoteState.State)
+	data.Suspended = types.BoolValue(s.Suspended)
+	data.OsName = types.StringValue(s.OS.Name)
+	data.PrimaryIPv4 = types.StringValue("")
+	data.IPv4Addresses = []types.String{}
+	data.IPv6Subnets = []types.String{}
+	data.Tags = []types.String{}
+	for _, tag := range s.Tags {
+		data.Tags = append(data.Tags, types.StringValue(tag))
+	}
+
+	for _, iface := range s.Network.Interfaces {
+		for _, ip := range iface.IPv4 {
+			if data.PrimaryIPv4.ValueString() == "" && iface.Type != "private" {
+				data.PrimaryIPv4 = types.StringValue(ip.Address)
+			}
+			data.IPv4Addresses = append(data.IPv4Addresses, types.StringValue(ip.Address))
+		}
+		for _, subnet := range iface.IPv6 {
+			data.IPv6Subnets = append(data.IPv6Subnets, types.StringValue(fmt.Sprintf("%s/%d", subnet.Subnet, subnet.Cidr)))
+		}
+	}
+
+	return data
+}
+
+// serverDataSourceAttributes returns the read-only server attributes shared by
+// virtfusion_server and the elements of virtfusion_servers.
+func serverDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Server ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Server name.",
+			Computed:            true,
+		},
+		"uuid": schema.StringAttribute{
+			MarkdownDescription: "Server UUID.",
+			Computed:            true,
+		},
+		"user_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the user owning the server.",
+			Computed:            true,
+		},
+		"package_id": schema.Int64Attribute{
+			MarkdownDescription: "Package ID.",
+			Computed:            true,
+		},
+		"hypervisor_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the hypervisor the server runs on.",
+			Computed:            true,
+		},
+		"ipv4": schema.Int64Attribute{
+			MarkdownDescription: "Number of public IPv4 addresses.",
+			Computed:            true,
+		},
+		"ipv6": schema.Int64Attribute{
+			MarkdownDescription: "Number of IPv6 subnets.",
+			Computed:            true,
+		},
+		"private_ips": schema.Int64Attribute{
+			MarkdownDescription: "Number of private IPv4 addresses.",
+			Computed:            true,
+		},
+		"storage": schema.Int64Attribute{
+			MarkdownDescription: "Primary storage size in GB.",
+			Computed:            true,
+		},
+		"memory": schema.Int64Attribute{
+			MarkdownDescription: "Memory in MB.",
+			Computed:            true,
+		},
+		"cores": schema.Int64Attribute{
+			MarkdownDescription: "CPU cores.",
+			Computed:            true,
+		},
+		"traffic": schema.Int64Attribute{
+			MarkdownDescription: "Traffic allowance in GB. 0=Unlimited",
+			Computed:            true,
+		},
+		"inbound_network_speed": schema.Int64Attribute{
+			MarkdownDescription: "Inbound network speed in kB/s.",
+			Computed:            true,
+		},
+		"outbound_network_speed": schema.Int64Attribute{
+			MarkdownDescription: "Outbound network speed in kB/s.",
+			Computed:            true,
+		},
+		"storage_profile": schema.Int64Attribute{
+			MarkdownDescription: "Always null; storage profiles are not reported by the API.",
+			Computed:            true,
+		},
+		"network_profile": schema.Int64Attribute{
+			MarkdownDescription: "Always null; network profiles are not reported by the API.",
+			Computed:            true,
+		},
+		"backup_plan_id": schema.Int64Attribute{
+			MarkdownDescription: "Assigned backup plan, or null if none.",
+			Computed:            true,
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Server hostname.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Provisioning state, e.g. `complete`.",
+			Computed:            true,
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "Live power state reported by the hypervisor, e.g. `running` or `stopped`.",
+			Computed:            true,
+		},
+		"suspended": schema.BoolAttribute{
+			MarkdownDescription: "Whether the server is suspended.",
+			Computed:            true,
+		},
+		"os_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the installed operating system.",
+			Computed:            true,
+		},
+		"primary_ipv4": schema.StringAttribute{
+			MarkdownDescription: "First public IPv4 address, or empty if there is none.",
+			Computed:            true,
+		},
+		"ipv4_addresses": schema.ListAttribute{
+			MarkdownDescription: "All IPv4 addresses, public and private.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"ipv6_subnets": schema.ListAttribute{
+			MarkdownDescription: "All IPv6 subnets in CIDR notation.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Tags assigned to the server.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func (d *VirtfusionServerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server"
+}
+
+func (d *VirtfusionServerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverDataSourceAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "Server ID. Exactly one of `id`, `name` or `uuid` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Server name. Exactly one of `id`, `name` or `uuid` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["uuid"] = schema.StringAttribute{
+		MarkdownDescription: "Server UUID. Exactly one of `id`, `name` or `uuid` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing server by ID, name or UUID.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *VirtfusionServerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("uuid"),
+		),
+	}
+}
+
+func (d *VirtfusionServerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionServerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ID.ValueInt64()
+	if data.ID.IsNull() {
+		// Names and UUIDs can only be resolved from the server listing
+		servers, err := fetchServers(d.config, 0)
+		if err != nil {
+			resp.Diagnostics.AddError("Server Lookup Failed", err.Error())
+			return
+		}
+
+		var matches []apiServer
+		for _, s := range servers {
+			if !data.Name.IsNull() && s.Name == data.Name.ValueString() {
+				matches = append(matches, s)
+			}
+			if !data.UUID.IsNull() && s.UUID == data.UUID.ValueString() {
+				matches = append(matches, s)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError("Server Not Found", "No server matches the given name or UUID.")
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Servers Found",
+				fmt.Sprintf("%d servers are named %q; look the server up by ID or UUID instead.", len(matches), data.Name.ValueString()),
+			)
+			return
+		}
+		serverID = matches[0].ID
+	}
+
+	server, err := fetchServer(d.config, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Server Lookup Failed", err.Error())
+		return
+	}
+
+	data = newServerDataSourceModel(server)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerFirewallResource{}
+var _ resource.ResourceWithImportState = &VirtfusionServerFirewallResource{}
+
+func NewVirtfusionServerFirewallResource() resource.Resource {
+	return &VirtfusionServerFirewallResource{}
+}
+
+type VirtfusionServerFirewallResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerFirewallResourceModel struct {
+	ID        types.Int64         `tfsdk:"id"`
+	ServerID  types.Int64         `tfsdk:"server_id"`
+	Interface types.String        `tfsdk:"interface"`
+	Enabled   types.Bool          `tfsdk:"enabled"`
+	Rules     []firewallRuleModel `tfsdk:"rules"`
+}
+
+type firewallRuleModel struct {
+	Direction  types.String `tfsdk:"direction"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Ports      types.String `tfsdk:"ports"`
+	SourceCIDR types.String `tfsdk:"source_cidr"`
+	Action     types.String `tfsdk:"action"`
+}
+
+// apiFirewall is a server interface's firewall as returned by the VirtFusion
+// API. Rules are evaluated in order.
+type apiFirewall struct {
+	Enabled bool              `json:"enabled"`
+	Rules   []apiFirewallRule `json:"rules"`
+}
+
+type apiFirewallRule struct {
+	Direction string `json:"direction"`
+	Protocol  string `json:"protocol"`
+	Ports     string `json:"ports,omitempty"`
+	Source    string `json:"source,omitempty"`
+	Action    string `json:"action"`
+}
+
+// firewallPortsPattern matches a port, a port range, or a comma-separated
+// list of either, e.g. "22", "8000-8100" or "80,443".
+var firewallPortsPattern = regexp.MustCompile(`^\d{1,5}(-\d{1,5})?(,\d{1,5}(-\d{1,5})?)*$`)
+
+func (r *VirtfusionServerFirewallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_firewall"
+}
+
+func (r *VirtfusionServerFirewallResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the firewall of a server's network interface. The rule list is replaced as a whole on every change. " +
+			"Destroying the resource removes all rules and disables the firewall.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Same as `server_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Network interface the firewall applies to: `primary` or `secondary` (default: `primary`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("primary"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary", "secondary"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the firewall is enforced (default: true).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Firewall rules, evaluated in order.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Traffic direction: `in` or `out`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("in", "out"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol: `tcp`, `udp`, `icmp` or `any`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("tcp", "udp", "icmp", "any"),
+							},
+						},
+						"ports": schema.StringAttribute{
+							MarkdownDescription: "Port, port range or comma-separated list, e.g. `22`, `8000-8100` or `80,443`. Omit to match all ports.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(firewallPortsPattern, "must be a port, a port range or a comma-separated list of them"),
+							},
+						},
+						"source_cidr": schema.StringAttribute{
+							MarkdownDescription: "Source network in CIDR notation. Omit to match any source.",
+							Optional:            true,
+							Validators: []validator.String{
+								cidrValidator{},
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action for matching traffic: `accept`, `drop` or `reject`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("accept", "drop", "reject"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionServerFirewallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerFirewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerFirewallResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ServerID
+	r.apply(ctx, &data, types.BoolValue(!data.Enabled.ValueBool()), &resp.State, &resp.Diagnostics)
+}
+
+func (r *VirtfusionServerFirewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerFirewallResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	firewall, found, err := r.fetch(data.ServerID.ValueInt64(), data.Interface.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.ServerID
+	data.Enabled = types.BoolValue(firewall.Enabled)
+	data.Rules = flattenFirewallRules(firewall.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerFirewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VirtfusionServerFirewallResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, state.Enabled, &resp.State, &resp.Diagnostics)
+}
+
+func (r *VirtfusionServerFirewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionServerFirewallResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.replaceRules(data.ServerID.ValueInt64(), data.Interface.ValueString(), []apiFirewallRule{}); err != nil {
+		resp.Diagnostics.AddError("Firewall Update Failed", err.Error())
+		return
+	}
+	if err := r.setEnabled(data.ServerID.ValueInt64(), data.Interface.ValueString(), false); err != nil {
+		resp.Diagnostics.AddError("Firewall Update Failed", err.Error())
+		return
+	}
+}
+
+func (r *VirtfusionServerFirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverPart, iface, hasInterface := strings.Cut(req.ID, "/")
+	if !hasInterface {
+		iface = "primary"
+	}
+	serverID, err := strconv.ParseInt(serverPart, 10, 64)
+	if err != nil || (iface != "primary" && iface != "secondary") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected <server_id> or <server_id>/<interface>, where interface is primary or secondary, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), iface)...)
+}
+
+// apply replaces the interface's rules in a single request, then enables or
+// disables the firewall, and saves the result to state. The API has no way
+// to do both at once, so when only the rules could be changed, the new rules
+// are saved together with the firewall's actual enabled state and the apply
+// is reported as partial; the next plan then retries the toggle.
+func (r *VirtfusionServerFirewallResource) apply(ctx context.Context, data *VirtfusionServerFirewallResourceModel, previouslyEnabled types.Bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	serverID, iface := data.ServerID.ValueInt64(), data.Interface.ValueString()
+
+	if err := r.replaceRules(serverID, iface, expandFirewallRules(data.Rules)); err != nil {
+		diags.AddError("Firewall Update Failed", err.Error())
+		return
+	}
+
+	if err := r.setEnabled(serverID, iface, data.Enabled.ValueBool()); err != nil {
+		wanted := "disabled"
+		if data.Enabled.ValueBool() {
+			wanted = "enabled"
+		}
+		data.Enabled = previouslyEnabled
+		if firewall, found, fetchErr := r.fetch(serverID, iface); fetchErr == nil && found {
+			data.Enabled = types.BoolValue(firewall.Enabled)
+		}
+		diags.Append(state.Set(ctx, data)...)
+		diags.AddError(
+			"Firewall Partially Applied",
+			fmt.Sprintf("The firewall rules were replaced, but the firewall could not be %s: %s", wanted, err),
+		)
+		return
+	}
+
+	diags.Append(state.Set(ctx, data)...)
+}
+
+// fetch returns the interface's firewall. found is false when the server or
+// interface does not exist.
+func (r *VirtfusionServerFirewallResource) fetch(serverID int64, iface string) (firewall apiFirewall, found bool, err error) {
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/firewall/" + iface
+	httpReq, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return apiFirewall{}, false, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return apiFirewall{}, false, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return apiFirewall{}, false, nil
+	}
+	if httpResp.StatusCode != 200 {
+		return apiFirewall{}, false, fmt.Errorf("unexpected status %d while reading firewall", httpResp.StatusCode)
+	}
+
+	var respData struct {
+		Data apiFirewall `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		return apiFirewall{}, false, err
+	}
+	return respData.Data, true, nil
+}
+
+// replaceRules replaces the interface's rules in a single request.
+func (r *VirtfusionServerFirewallResource) replaceRules(serverID int64, iface string, rules []apiFirewallRule) error {
+	body, _ := json.Marshal(map[string]interface{}{
+		"rules": rules,
+	})
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/firewall/" + iface + "/rules"
+	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while replacing firewall rules", httpResp.StatusCode)
+	}
+	return nil
+}
+
+// setEnabled enables or disables the interface's firewall.
+func (r *VirtfusionServerFirewallResource) setEnabled(serverID int64, iface string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/firewall/" + iface + "/" + action
+	httpReq, err := http.NewRequest("POST", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while trying to %s firewall", httpResp.StatusCode, action)
+	}
+	return nil
+}
+
+// expandFirewallRules converts the configured rules to their API form,
+// keeping their order.
+func expandFirewallRules(rules []firewallRuleModel) []apiFirewallRule {
+	result := []apiFirewallRule{}
+	for _, rule := range rules {
+		result = append(result, apiFirewallRule{
+			Direction: rule.Direction.ValueString(),
+			Protocol:  rule.Protocol.ValueString(),
+			Ports:     rule.Ports.ValueString(),
+			Source:    rule.SourceCIDR.ValueString(),
+			Action:    rule.Action.ValueString(),
+		})
+	}
+	return result
+}
+
+// flattenFirewallRules converts rules read from the API to the model. Empty
+// ports and sources mean "any" and map to null, matching omitted arguments.
+func flattenFirewallRules(rules []apiFirewallRule) []firewallRuleModel {
+	result := []firewallRuleModel{}
+	for _, rule := range rules {
+		model := firewallRuleModel{
+			Direction:  types.StringValue(rule.Direction),
+			Protocol:   types.StringValue(rule.Protocol),
+			Ports:      types.StringNull(),
+			SourceCIDR: types.StringNull(),
+			Action:     types.StringValue(rule.Action),
+		}
+		if rule.Ports != "" {
+			model.Ports = types.StringValue(rule.Ports)
+		}
+		if rule.Source != "" {
+			model.SourceCIDR = types.StringValue(rule.Source)
+		}
+		result = append(result, model)
+	}
+	return result
+}
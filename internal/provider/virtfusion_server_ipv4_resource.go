@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerIPv4Resource{}
+var _ resource.ResourceWithImportState = &VirtfusionServerIPv4Resource{}
+
+func NewVirtfusionServerIPv4Resource() resource.Resource {
+	return &VirtfusionServerIPv4Resource{}
+}
+
+type VirtfusionServerIPv4Resource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerIPv4ResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ServerID  types.Int64  `tfsdk:"server_id"`
+	Interface types.String `tfsdk:"interface"`
+	BlockID   types.Int64  `tfsdk:"block_id"`
+	Address   types.String `tfsdk:"address"`
+	Gateway   types.String `tfsdk:"gateway"`
+	Netmask   types.String `tfsdk:"netmask"`
+}
+
+// apiServerIPv4 is an IPv4 address assigned to a server.
+type apiServerIPv4 struct {
+	ID      int64  `json:"id"`
+	BlockID int64  `json:"blockId"`
+	Address string `json:"address"`
+	Gateway string `json:"gateway"`
+	Netmask string `json:"netmask"`
+}
+
+func (r *VirtfusionServerIPv4Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_ipv4"
+}
+
+func (r *VirtfusionServerIPv4Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns an additional IPv4 address to a server. Destroying the resource releases the address back to its block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the address assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Network interface to attach the address to: `primary` or `secondary` (default: `primary`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("primary"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary", "secondary"),
+				},
+			},
+			"block_id": schema.Int64Attribute{
+				MarkdownDescription: "IP block to allocate from. Defaults to any block available to the server's hypervisor.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Address to assign. Defaults to the next free address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					ipv4AddressValidator{},
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Gateway of the address's block.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"netmask": schema.StringAttribute{
+				MarkdownDescription: "Netmask of the address's block.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionServerIPv4Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerIPv4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerIPv4ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := assignmentPayload(data.Interface, data.BlockID)
+	if !data.Address.IsUnknown() && !data.Address.IsNull() {
+		payload["address"] = data.Address.ValueString()
+	}
+
+	var ip apiServerIPv4
+	if err := assignServerAddress(r.config, data.ServerID.ValueInt64(), "ipv4", payload, &ip); err != nil {
+		resp.Diagnostics.AddError("Address Assignment Failed", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(ip.ID)
+	data.BlockID = types.Int64Value(ip.BlockID)
+	data.Address = types.StringValue(ip.Address)
+	data.Gateway = types.StringValue(ip.Gateway)
+	data.Netmask = types.StringValue(ip.Netmask)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the assignment from the server's interfaces and drops the
+// resource from state once the address, or the server itself, is gone.
+func (r *VirtfusionServerIPv4Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerIPv4ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, found, err := findServer(r.config, data.ServerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Server Lookup Failed", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	for _, iface := range server.Network.Interfaces {
+		for _, ip := range iface.IPv4 {
+			if ip.ID != data.ID.ValueInt64() {
+				continue
+			}
+			if iface.Type == "primary" || iface.Type == "secondary" {
+				data.Interface = types.StringValue(iface.Type)
+			}
+			data.BlockID = types.Int64Value(ip.BlockID)
+			data.Address = types.StringValue(ip.Address)
+			data.Gateway = types.StringValue(ip.Gateway)
+			data.Netmask = types.StringValue(ip.Netmask)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called; every argument forces a new assignment.
+func (r *VirtfusionServerIPv4Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionServerIPv4ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerIPv4Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionServerIPv4ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := releaseServerAddress(r.config, data.ServerID.ValueInt64(), "ipv4", data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Address Release Failed", err.Error())
+		return
+	}
+}
+
+func (r *VirtfusionServerIPv4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importServerAddress(ctx, req, resp)
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerIPv6SubnetResource{}
+var _ resource.ResourceWithImportState = &VirtfusionServerIPv6SubnetResource{}
+
+func NewVirtfusionServerIPv6SubnetResource() resource.Resource {
+	return &VirtfusionServerIPv6SubnetResource{}
+}
+
+type VirtfusionServerIPv6SubnetResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerIPv6SubnetResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ServerID  types.Int64  `tfsdk:"server_id"`
+	Interface types.String `tfsdk:"interface"`
+	BlockID   types.Int64  `tfsdk:"block_id"`
+	Subnet    types.String `tfsdk:"subnet"`
+}
+
+// apiServerIPv6Subnet is an IPv6 subnet assigned to a server.
+type apiServerIPv6Subnet struct {
+	ID      int64  `json:"id"`
+	BlockID int64  `json:"blockId"`
+	Subnet  string `json:"subnet"`
+	Cidr    int64  `json:"cidr"`
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_ipv6_subnet"
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns an additional IPv6 subnet to a server. Destroying the resource releases the subnet back to its block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the subnet assignment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Network interface to route the subnet to: `primary` or `secondary` (default: `primary`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("primary"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary", "secondary"),
+				},
+			},
+			"block_id": schema.Int64Attribute{
+				MarkdownDescription: "IP block to allocate from. Defaults to any block available to the server's hypervisor.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet to assign in CIDR notation, e.g. `2001:db8:0:1::/64`. Defaults to the next free subnet.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					ipv6CidrValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerIPv6SubnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := assignmentPayload(data.Interface, data.BlockID)
+	if !data.Subnet.IsUnknown() && !data.Subnet.IsNull() {
+		payload["subnet"] = data.Subnet.ValueString()
+	}
+
+	var subnet apiServerIPv6Subnet
+	if err := assignServerAddress(r.config, data.ServerID.ValueInt64(), "ipv6", payload, &subnet); err != nil {
+		resp.Diagnostics.AddError("Subnet Assignment Failed", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(subnet.ID)
+	data.BlockID = types.Int64Value(subnet.BlockID)
+	if data.Subnet.IsUnknown() || !sameNetwork(data.Subnet.ValueString(), formatSubnet(subnet.Subnet, subnet.Cidr)) {
+		data.Subnet = types.StringValue(formatSubnet(subnet.Subnet, subnet.Cidr))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the assignment from the server's interfaces and drops the
+// resource from state once the subnet, or the server itself, is gone.
+func (r *VirtfusionServerIPv6SubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerIPv6SubnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, found, err := findServer(r.config, data.ServerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Server Lookup Failed", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	for _, iface := range server.Network.Interfaces {
+		for _, subnet := range iface.IPv6 {
+			if subnet.ID != data.ID.ValueInt64() {
+				continue
+			}
+			if iface.Type == "primary" || iface.Type == "secondary" {
+				data.Interface = types.StringValue(iface.Type)
+			}
+			data.BlockID = types.Int64Value(subnet.BlockID)
+			if !sameNetwork(data.Subnet.ValueString(), formatSubnet(subnet.Subnet, subnet.Cidr)) {
+				data.Subnet = types.StringValue(formatSubnet(subnet.Subnet, subnet.Cidr))
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called; every argument forces a new assignment.
+func (r *VirtfusionServerIPv6SubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionServerIPv6SubnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionServerIPv6SubnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := releaseServerAddress(r.config, data.ServerID.ValueInt64(), "ipv6", data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Subnet Release Failed", err.Error())
+		return
+	}
+}
+
+func (r *VirtfusionServerIPv6SubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importServerAddress(ctx, req, resp)
+}
+
+// formatSubnet joins a subnet address and prefix length into CIDR notation.
+func formatSubnet(address string, cidr int64) string {
+	return address + "/" + strconv.FormatInt(cidr, 10)
+}
+
+// sameNetwork reports whether two CIDR strings describe the same network,
+// ignoring differences in how the addresses are written.
+func sameNetwork(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return netA.String() == netB.String()
+}
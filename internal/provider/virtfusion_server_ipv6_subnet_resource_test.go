@@ -0,0 +1,49 @@
+package provider
+
+import "testing"
+
+func TestFormatSubnet(t *testing.T) {
+	tests := []struct {
+		address string
+		cidr    int64
+		want    string
+	}{
+		{"2001:db8:1::", 64, "2001:db8:1::/64"},
+		{"2001:db8::", 48, "2001:db8::/48"},
+		{"203.0.113.0", 24, "203.0.113.0/24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatSubnet(tt.address, tt.cidr); got != tt.want {
+				t.Errorf("formatSubnet(%q, %d) = %q, want %q", tt.address, tt.cidr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameNetwork(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"identical", "2001:db8:1::/64", "2001:db8:1::/64", true},
+		{"expanded zeros", "2001:0db8:0001:0000::/64", "2001:db8:1::/64", true},
+		{"upper case", "2001:DB8:1::/64", "2001:db8:1::/64", true},
+		{"host bits set", "2001:db8:1::1/64", "2001:db8:1::/64", true},
+		{"other prefix length", "2001:db8:1::/64", "2001:db8:1::/56", false},
+		{"other network", "2001:db8:1::/64", "2001:db8:2::/64", false},
+		{"invalid but equal", "not-a-subnet", "not-a-subnet", true},
+		{"invalid and valid", "not-a-subnet", "2001:db8:1::/64", false},
+		{"empty", "", "2001:db8:1::/64", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameNetwork(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameNetwork(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
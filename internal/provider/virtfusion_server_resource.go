@@ -8,13 +8,17 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure implementation
 var _ resource.Resource = &VirtfusionServerResource{}
+var _ resource.ResourceWithModifyPlan = &VirtfusionServerResource{}
 
 func NewVirtfusionServerResource() resource.Resource {
 	return &VirtfusionServerResource{}
@@ -26,6 +30,13 @@ type VirtfusionServerResource struct {
 }
 
 type VirtfusionServerResourceModel struct {
+	serverSpecModel
+	TrafficUsed types.Int64 `tfsdk:"traffic_used"`
+}
+
+// serverSpecModel holds the server settings shared by the resource and the
+// server data sources.
+type serverSpecModel struct {
 	ID               types.Int64 `tfsdk:"id"`
 	UserID           types.Int64 `tfsdk:"user_id"`
 	PackageID        types.Int64 `tfsdk:"package_id"`
@@ -41,6 +52,7 @@ type VirtfusionServerResourceModel struct {
 	OutboundSpeed    types.Int64 `tfsdk:"outbound_network_speed"`
 	StorageProfileID types.Int64 `tfsdk:"storage_profile"`
 	NetworkProfileID types.Int64 `tfsdk:"network_profile"`
+	BackupPlanID     types.Int64 `tfsdk:"backup_plan_id"`
 }
 
 func (r *VirtfusionServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,9 +61,13 @@ func (r *VirtfusionServerResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *VirtfusionServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a server. Planning a new server fails early when no hypervisor in the group has enough free memory, CPU cores and storage for it.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"user_id": schema.Int64Attribute{
 				Required: true,
@@ -95,6 +111,16 @@ func (r *VirtfusionServerResource) Schema(ctx context.Context, req resource.Sche
 			"network_profile": schema.Int64Attribute{
 				Optional: true,
 			},
+			"backup_plan_id": schema.Int64Attribute{
+				Optional: true,
+			},
+			"traffic_used": schema.Int64Attribute{
+				MarkdownDescription: "Traffic used in the current period, in bytes. Same figure as `total_bytes` on the `virtfusion_server_traffic` data source.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -117,6 +143,84 @@ func (r *VirtfusionServerResource) Configure(ctx context.Context, req resource.C
 	r.config = config
 }
 
+// ModifyPlan checks that the hypervisor group still has a hypervisor with room
+// for a new server, so a build into a full group fails at plan time. Sizes
+// that are not set fall back to the package's.
+func (r *VirtfusionServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only new servers are placed on a hypervisor
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.config == nil {
+		return
+	}
+
+	var data VirtfusionServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := r.config.HypervisorGroup
+	if data.HypervisorID.IsUnknown() {
+		return
+	}
+	if !data.HypervisorID.IsNull() {
+		groupID = data.HypervisorID.ValueInt64()
+	}
+	if groupID <= 0 {
+		return
+	}
+
+	var memory, cores, storage int64
+	packageID := r.config.ResourcePackage
+	if !data.PackageID.IsNull() && !data.PackageID.IsUnknown() {
+		packageID = data.PackageID.ValueInt64()
+	}
+	if packageID > 0 {
+		packages, err := r.config.packages()
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Check Hypervisor Capacity",
+				fmt.Sprintf("Could not fetch package %d: %s", packageID, err),
+			)
+			return
+		}
+		for _, pkg := range packages {
+			if pkg.ID == packageID {
+				memory, cores, storage = pkg.Memory, pkg.CPUCores, pkg.PrimaryStorage
+			}
+		}
+	}
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
+		memory = data.Memory.ValueInt64()
+	}
+	if !data.Cores.IsNull() && !data.Cores.IsUnknown() {
+		cores = data.Cores.ValueInt64()
+	}
+	if !data.Storage.IsNull() && !data.Storage.IsUnknown() {
+		storage = data.Storage.ValueInt64()
+	}
+
+	available, _, err := availableHypervisorResources(r.config, groupID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Hypervisor Capacity",
+			fmt.Sprintf("Could not fetch the capacity of hypervisor group %d: %s", groupID, err),
+		)
+		return
+	}
+
+	for _, res := range available {
+		if res.fits(memory, cores, storage) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("hypervisor_id"),
+		"Hypervisor Group Full",
+		fmt.Sprintf("No available hypervisor in group %d has room for a server with %d MB memory, %d CPU cores and %d GB storage.", groupID, memory, cores, storage),
+	)
+}
+
 func (r *VirtfusionServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VirtfusionServerResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -191,6 +295,15 @@ func (r *VirtfusionServerResource) Create(ctx context.Context, req resource.Crea
 	if id, ok := respData["id"].(float64); ok {
 		data.ID = types.Int64Value(int64(id))
 	}
+	data.TrafficUsed = types.Int64Value(0)
+
+	if !data.BackupPlanID.IsNull() {
+		if err := r.setBackupPlan(data.ID.ValueInt64(), data.BackupPlanID.ValueInt64()); err != nil {
+			// The server exists at this point, so keep it in state
+			data.BackupPlanID = types.Int64Null()
+			resp.Diagnostics.AddError("Backup Plan Assignment Failed", err.Error())
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -222,18 +335,40 @@ func (r *VirtfusionServerResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	var respData map[string]interface{}
+	var respData struct {
+		Data apiServer `json:"data"`
+	}
 	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
 		resp.Diagnostics.AddError("Error decoding API response", err.Error())
 		return
 	}
 
+	// The API reports no plan as 0; a configured 0 is kept, anything else
+	// becomes null
+	if planID := respData.Data.BackupPlanID; planID > 0 {
+		data.BackupPlanID = types.Int64Value(planID)
+	} else if data.BackupPlanID.ValueInt64() != 0 {
+		data.BackupPlanID = types.Int64Null()
+	}
+
+	// Usage is informational, so a failed lookup keeps the previous value
+	// rather than blocking every plan for the server
+	if traffic, err := fetchServerTraffic(r.config, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Traffic Lookup Failed",
+			fmt.Sprintf("Could not refresh traffic_used for server %d: %s", data.ID.ValueInt64(), err),
+		)
+	} else {
+		data.TrafficUsed = types.Int64Value(traffic.Current.Total)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VirtfusionServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VirtfusionServerResourceModel
+	var data, state VirtfusionServerResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -266,6 +401,41 @@ func (r *VirtfusionServerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// A null limit is left alone; sending 0 would make traffic unlimited
+	if !data.Traffic.IsNull() && !data.Traffic.Equal(state.Traffic) {
+		payload := map[string]interface{}{
+			"traffic": data.Traffic.ValueInt64(),
+		}
+		if err := r.modify(state.ID.ValueInt64(), "traffic", payload); err != nil {
+			resp.Diagnostics.AddError("Traffic Limit Update Failed", err.Error())
+			return
+		}
+	}
+
+	// Only send the speeds that are set; an omitted speed keeps its current
+	// value, while 0 would make it unlimited
+	speeds := map[string]interface{}{}
+	if !data.InboundSpeed.IsNull() && !data.InboundSpeed.Equal(state.InboundSpeed) {
+		speeds["inAverage"] = data.InboundSpeed.ValueInt64()
+	}
+	if !data.OutboundSpeed.IsNull() && !data.OutboundSpeed.Equal(state.OutboundSpeed) {
+		speeds["outAverage"] = data.OutboundSpeed.ValueInt64()
+	}
+	if len(speeds) > 0 {
+		if err := r.modify(state.ID.ValueInt64(), "networkSpeed", speeds); err != nil {
+			resp.Diagnostics.AddError("Network Speed Update Failed", err.Error())
+			return
+		}
+	}
+
+	// A plan ID of 0 removes the server from its backup plan
+	if !data.BackupPlanID.Equal(state.BackupPlanID) {
+		if err := r.setBackupPlan(state.ID.ValueInt64(), data.BackupPlanID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Backup Plan Assignment Failed", err.Error())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -292,3 +462,162 @@ func (r *VirtfusionServerResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 }
+
+// modify applies a single in-place change through one of the server's modify
+// endpoints, e.g. "traffic" or "networkSpeed".
+func (r *VirtfusionServerResource) modify(serverID int64, setting string, payload map[string]interface{}) error {
+	body, _ := json.Marshal(payload)
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/modify/" + setting
+	httpReq, _ := http.NewRequest("PUT", reqURL, bytes.NewBuffer(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while modifying %s", httpResp.StatusCode, setting)
+	}
+	return nil
+}
+
+// setBackupPlan assigns the server to a backup plan, or removes it from its
+// current plan when planID is 0.
+func (r *VirtfusionServerResource) setBackupPlan(serverID, planID int64) error {
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/backups/plan/" + strconv.FormatInt(planID, 10)
+	httpReq, _ := http.NewRequest("PUT", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while assigning backup plan %d", httpResp.StatusCode, planID)
+	}
+	return nil
+}
+
+// apiServer is a server as returned by the VirtFusion API.
+type apiServer struct {
+	ID           int64    `json:"id"`
+	OwnerID      int64    `json:"ownerId"`
+	HypervisorID int64    `json:"hypervisorId"`
+	PackageID    int64    `json:"packageId"`
+	BackupPlanID int64    `json:"backupPlanId"`
+	Name         string   `json:"name"`
+	Hostname     string   `json:"hostname"`
+	UUID         string   `json:"uuid"`
+	State        string   `json:"state"`
+	Suspended    bool     `json:"suspended"`
+	Tags         []string `json:"tags"`
+	Resources    struct {
+		Memory   int64 `json:"memory"`
+		Storage  int64 `json:"storage"`
+		Traffic  int64 `json:"traffic"`
+		CPUCores int64 `json:"cpuCores"`
+	} `json:"resources"`
+	Network struct {
+		Interfaces []struct {
+			Type       string                `json:"type"`
+			InAverage  int64                 `json:"inAverage"`
+			OutAverage int64                 `json:"outAverage"`
+			IPv4       []apiServerIPv4       `json:"ipv4"`
+			IPv6       []apiServerIPv6Subnet `json:"ipv6"`
+		} `json:"interfaces"`
+	} `json:"network"`
+	OS struct {
+		Name string `json:"name"`
+	} `json:"os"`
+	RemoteState struct {
+		State string `json:"state"`
+	} `json:"remoteState"`
+}
+
+// setFromAPI copies the server's current configuration into the model.
+// Profiles are not reported by the API and are left null.
+func (m *serverSpecModel) setFromAPI(s apiServer) {
+	var ipv4, ipv6, privateIPs int64
+	var inSpeed, outSpeed int64
+	for _, iface := range s.Network.Interfaces {
+		if iface.Type == "private" {
+			privateIPs += int64(len(iface.IPv4))
+			continue
+		}
+		ipv4 += int64(len(iface.IPv4))
+		ipv6 += int64(len(iface.IPv6))
+		if inSpeed == 0 && outSpeed == 0 {
+			inSpeed, outSpeed = iface.InAverage, iface.OutAverage
+		}
+	}
+
+	m.ID = types.Int64Value(s.ID)
+	m.UserID = types.Int64Value(s.OwnerID)
+	m.PackageID = types.Int64Value(s.PackageID)
+	m.HypervisorID = types.Int64Value(s.HypervisorID)
+	m.IPv4 = types.Int64Value(ipv4)
+	m.IPv6 = types.Int64Value(ipv6)
+	m.PrivateIPs = types.Int64Value(privateIPs)
+	m.Storage = types.Int64Value(s.Resources.Storage)
+	m.Memory = types.Int64Value(s.Resources.Memory)
+	m.Cores = types.Int64Value(s.Resources.CPUCores)
+	m.Traffic = types.Int64Value(s.Resources.Traffic)
+	m.InboundSpeed = types.Int64Value(inSpeed)
+	m.OutboundSpeed = types.Int64Value(outSpeed)
+	m.StorageProfileID = types.Int64Null()
+	m.NetworkProfileID = types.Int64Null()
+	m.BackupPlanID = types.Int64Null()
+	if s.BackupPlanID > 0 {
+		m.BackupPlanID = types.Int64Value(s.BackupPlanID)
+	}
+}
+
+// fetchServer returns a single server including its live power state.
+func fetchServer(config *ProviderConfig, serverID int64) (apiServer, error) {
+	var respData struct {
+		Data apiServer `json:"data"`
+	}
+	apiPath := "/servers/" + strconv.FormatInt(serverID, 10) + "?remoteState=true"
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, apiPath, &respData); err != nil {
+		return apiServer{}, err
+	}
+	return respData.Data, nil
+}
+
+// findServer is fetchServer for resources attached to a server: found is
+// false when the server has been deleted, so they can drop out of state
+// instead of failing.
+func findServer(config *ProviderConfig, serverID int64) (server apiServer, found bool, err error) {
+	reqURL := config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "?remoteState=true"
+	httpReq, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return apiServer{}, false, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+config.ApiToken)
+
+	httpResp, err := config.Client.Do(httpReq)
+	if err != nil {
+		return apiServer{}, false, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return apiServer{}, false, nil
+	}
+	if httpResp.StatusCode != 200 {
+		return apiServer{}, false, fmt.Errorf("unexpected status %d while fetching server %d", httpResp.StatusCode, serverID)
+	}
+
+	var respData struct {
+		Data apiServer `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		return apiServer{}, false, err
+	}
+	return respData.Data, true, nil
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerRestoreResource{}
+
+func NewVirtfusionServerRestoreResource() resource.Resource {
+	return &VirtfusionServerRestoreResource{}
+}
+
+type VirtfusionServerRestoreResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerRestoreResourceModel struct {
+	ID         types.Int64    `tfsdk:"id"`
+	ServerID   types.Int64    `tfsdk:"server_id"`
+	BackupID   types.Int64    `tfsdk:"backup_id"`
+	Triggers   types.Map      `tfsdk:"triggers"`
+	Status     types.String   `tfsdk:"status"`
+	StartedAt  types.String   `tfsdk:"started_at"`
+	FinishedAt types.String   `tfsdk:"finished_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Restore states recorded in status.
+const (
+	restoreStatusRunning  = "running"
+	restoreStatusComplete = "complete"
+	restoreStatusFailed   = "failed"
+)
+
+func (r *VirtfusionServerRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_restore"
+}
+
+func (r *VirtfusionServerRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a server from a backup and waits for the restore to finish. " +
+			"The restore runs once, when the resource is created; change `backup_id` or `triggers` to restore again. " +
+			"A restore that is still running when the create timeout expires is kept as `running` and refreshed on later plans, so it is not started twice. " +
+			"A restore that fails while Terraform waits for it is recorded as `failed` and tainted, so the next apply runs it again.\n\n" +
+			"~> **Note:** Destroying this resource does nothing. A restore cannot be undone, and the server keeps the restored data.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the restore task.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server to restore.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the backup to restore from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, run the restore again.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "State of the restore: `running`, `complete` or `failed`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "When the restore started.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finished_at": schema.StringAttribute{
+				MarkdownDescription: "When the restore finished.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *VirtfusionServerRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10) +
+		"/backups/" + strconv.FormatInt(data.BackupID.ValueInt64(), 10) + "/restore"
+
+	httpReq, err := http.NewRequest("POST", reqURL, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 && httpResp.StatusCode != 202 {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Status: %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	var respData struct {
+		Data struct {
+			QueueID int64 `json:"queueId"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	// The restore is queued and cannot be taken back, so record it before
+	// waiting; otherwise a timeout would leave no state and the next apply
+	// would restore the server a second time
+	data.ID = types.Int64Value(respData.Data.QueueID)
+	data.setFromTask(apiTask{ID: respData.Data.QueueID})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Neither case is an error: the restore is already queued, and tainting
+	// the resource would run it again on the next apply
+	task, err := waitForTask(waitCtx, r.config, respData.Data.QueueID)
+	if stoppedWaiting(err) {
+		resp.Diagnostics.AddWarning(
+			"Restore Still Running",
+			fmt.Sprintf("Restore task %d had not finished when the create timeout expired. The restore continues in the background and its status is refreshed on the next plan.", respData.Data.QueueID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Restore Status Unknown",
+			fmt.Sprintf("Could not check restore task %d: %s. The restore may still be running; its status is refreshed on the next plan.", respData.Data.QueueID, err),
+		)
+		return
+	}
+
+	data.setFromTask(task)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if task.Failed {
+		resp.Diagnostics.AddError(
+			"Restore Failed",
+			fmt.Sprintf("Restoring server %d from backup %d failed.", data.ServerID.ValueInt64(), data.BackupID.ValueInt64()),
+		)
+		return
+	}
+}
+
+// Read refreshes a restore that was still running when Create stopped
+// waiting; a finished restore has nothing to refresh.
+func (r *VirtfusionServerRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerRestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.ValueString() == restoreStatusRunning {
+		task, err := fetchTask(r.config, data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Restore Status Lookup Failed", err.Error())
+			return
+		}
+		data.setFromTask(task)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only runs when timeouts change; every other argument forces a new
+// restore.
+func (r *VirtfusionServerRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionServerRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the restore from state; there is nothing to undo.
+func (r *VirtfusionServerRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// setFromTask records the state of the restore task in the model.
+func (m *VirtfusionServerRestoreResourceModel) setFromTask(task apiTask) {
+	switch {
+	case task.Finished == "":
+		m.Status = types.StringValue(restoreStatusRunning)
+	case task.Failed:
+		m.Status = types.StringValue(restoreStatusFailed)
+	default:
+		m.Status = types.StringValue(restoreStatusComplete)
+	}
+	m.StartedAt = types.StringValue(task.Started)
+	m.FinishedAt = types.StringValue(task.Finished)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionServerTrafficDataSource{}
+
+func NewVirtfusionServerTrafficDataSource() datasource.DataSource {
+	return &VirtfusionServerTrafficDataSource{}
+}
+
+type VirtfusionServerTrafficDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionServerTrafficDataSourceModel struct {
+	ServerID       types.Int64          `tfsdk:"server_id"`
+	IncludeHistory types.Bool           `tfsdk:"include_history"`
+	LimitGB        types.Int64          `tfsdk:"limit_gb"`
+	PeriodStart    types.String         `tfsdk:"period_start"`
+	PeriodEnd      types.String         `tfsdk:"period_end"`
+	InboundBytes   types.Int64          `tfsdk:"inbound_bytes"`
+	OutboundBytes  types.Int64          `tfsdk:"outbound_bytes"`
+	TotalBytes     types.Int64          `tfsdk:"total_bytes"`
+	History        []trafficPeriodModel `tfsdk:"history"`
+}
+
+type trafficPeriodModel struct {
+	PeriodStart   types.String `tfsdk:"period_start"`
+	PeriodEnd     types.String `tfsdk:"period_end"`
+	InboundBytes  types.Int64  `tfsdk:"inbound_bytes"`
+	OutboundBytes types.Int64  `tfsdk:"outbound_bytes"`
+	TotalBytes    types.Int64  `tfsdk:"total_bytes"`
+}
+
+// apiTrafficPeriod is a server's traffic usage over one billing period.
+type apiTrafficPeriod struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Inbound  int64  `json:"rx"`
+	Outbound int64  `json:"tx"`
+	Total    int64  `json:"total"`
+}
+
+// apiServerTraffic is a server's traffic usage as returned by the VirtFusion
+// API. Usage is in bytes; the limit is in GB, 0 meaning unlimited.
+type apiServerTraffic struct {
+	Limit   int64              `json:"limit"`
+	Current apiTrafficPeriod   `json:"current"`
+	History []apiTrafficPeriod `json:"history"`
+}
+
+func (d *VirtfusionServerTrafficDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_traffic"
+}
+
+func (d *VirtfusionServerTrafficDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	periodAttributes := map[string]schema.Attribute{
+		"period_start": schema.StringAttribute{
+			MarkdownDescription: "Start of the period.",
+			Computed:            true,
+		},
+		"period_end": schema.StringAttribute{
+			MarkdownDescription: "End of the period.",
+			Computed:            true,
+		},
+		"inbound_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Inbound traffic in bytes.",
+			Computed:            true,
+		},
+		"outbound_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Outbound traffic in bytes.",
+			Computed:            true,
+		},
+		"total_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Total traffic in bytes.",
+			Computed:            true,
+		},
+	}
+
+	attributes := map[string]schema.Attribute{
+		"server_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the server.",
+			Required:            true,
+		},
+		"include_history": schema.BoolAttribute{
+			MarkdownDescription: "Also return usage for past periods in `history`.",
+			Optional:            true,
+		},
+		"limit_gb": schema.Int64Attribute{
+			MarkdownDescription: "Traffic allowance per period in GB, or 0 for unlimited.",
+			Computed:            true,
+		},
+		"history": schema.ListNestedAttribute{
+			MarkdownDescription: "Usage for past periods, oldest first. Empty unless `include_history` is true.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: periodAttributes,
+			},
+		},
+	}
+	for name, attr := range periodAttributes {
+		attributes[name] = attr
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports a server's traffic usage for the current period and, optionally, past periods.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *VirtfusionServerTrafficDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionServerTrafficDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionServerTrafficDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	traffic, err := fetchServerTraffic(d.config, data.ServerID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Traffic Lookup Failed", err.Error())
+		return
+	}
+
+	data.LimitGB = types.Int64Value(traffic.Limit)
+	data.PeriodStart = types.StringValue(traffic.Current.Start)
+	data.PeriodEnd = types.StringValue(traffic.Current.End)
+	data.InboundBytes = types.Int64Value(traffic.Current.Inbound)
+	data.OutboundBytes = types.Int64Value(traffic.Current.Outbound)
+	data.TotalBytes = types.Int64Value(traffic.Current.Total)
+
+	data.History = []trafficPeriodModel{}
+	if data.IncludeHistory.ValueBool() {
+		sortTrafficPeriods(traffic.History)
+		for _, period := range traffic.History {
+			data.History = append(data.History, trafficPeriodModel{
+				PeriodStart:   types.StringValue(period.Start),
+				PeriodEnd:     types.StringValue(period.End),
+				InboundBytes:  types.Int64Value(period.Inbound),
+				OutboundBytes: types.Int64Value(period.Outbound),
+				TotalBytes:    types.Int64Value(period.Total),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// trafficTimeLayouts are the timestamp formats the API uses for period
+// boundaries.
+var trafficTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// sortTrafficPeriods orders periods oldest first. Periods whose start cannot
+// be parsed go last, compared as text among themselves.
+func sortTrafficPeriods(periods []apiTrafficPeriod) {
+	sort.SliceStable(periods, func(i, j int) bool {
+		a, okA := parseTrafficTime(periods[i].Start)
+		b, okB := parseTrafficTime(periods[j].Start)
+		switch {
+		case okA && okB:
+			return a.Before(b)
+		case okA != okB:
+			return okA
+		default:
+			return periods[i].Start < periods[j].Start
+		}
+	})
+}
+
+func parseTrafficTime(value string) (time.Time, bool) {
+	for _, layout := range trafficTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
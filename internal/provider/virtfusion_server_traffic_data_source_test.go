@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSortTrafficPeriods(t *testing.T) {
+	tests := []struct {
+		name   string
+		starts []string
+		want   []string
+	}{
+		{
+			name:   "newest first",
+			starts: []string{"2026-09-01 00:00:00", "2026-08-01 00:00:00", "2026-07-01 00:00:00"},
+			want:   []string{"2026-07-01 00:00:00", "2026-08-01 00:00:00", "2026-09-01 00:00:00"},
+		},
+		{
+			name:   "rfc3339 across time zones",
+			starts: []string{"2026-08-01T00:00:00+02:00", "2026-07-31T23:00:00Z"},
+			want:   []string{"2026-08-01T00:00:00+02:00", "2026-07-31T23:00:00Z"},
+		},
+		{
+			name:   "dates only",
+			starts: []string{"2026-10-01", "2025-12-01", "2026-01-01"},
+			want:   []string{"2025-12-01", "2026-01-01", "2026-10-01"},
+		},
+		{
+			name:   "unparseable falls back to text",
+			starts: []string{"b", "a"},
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "mixed parseable and unparseable",
+			starts: []string{"b", "2026-09-01 00:00:00", "a", "2026-07-01", "2026-08-01T00:00:00Z"},
+			want:   []string{"2026-07-01", "2026-08-01T00:00:00Z", "2026-09-01 00:00:00", "a", "b"},
+		},
+		{
+			name:   "unparseable that sorts before dates as text",
+			starts: []string{"2026-08-01", "1 August", "2026-07-01"},
+			want:   []string{"2026-07-01", "2026-08-01", "1 August"},
+		},
+		{
+			name:   "empty",
+			starts: []string{},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := make([]apiTrafficPeriod, len(tt.starts))
+			for i, start := range tt.starts {
+				periods[i].Start = start
+			}
+			sortTrafficPeriods(periods)
+
+			got := make([]string, len(periods))
+			for i, p := range periods {
+				got[i] = p.Start
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
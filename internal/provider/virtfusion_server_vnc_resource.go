@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionServerVNCResource{}
+var _ resource.ResourceWithImportState = &VirtfusionServerVNCResource{}
+
+func NewVirtfusionServerVNCResource() resource.Resource {
+	return &VirtfusionServerVNCResource{}
+}
+
+type VirtfusionServerVNCResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionServerVNCResourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Password types.String `tfsdk:"password"`
+}
+
+// apiVNC is a server's VNC console as returned by the VirtFusion API.
+type apiVNC struct {
+	Enabled  bool   `json:"enabled"`
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+	Port     int64  `json:"port"`
+	Password string `json:"password"`
+}
+
+func (r *VirtfusionServerVNCResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_server_vnc"
+}
+
+func (r *VirtfusionServerVNCResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a server's VNC console and exposes its connection details. Destroying the resource disables VNC.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Same as `server_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the server.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the VNC console is enabled (default: true).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host to connect to. Null while VNC is disabled.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port to connect to. Null while VNC is disabled.",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "VNC password. Null while VNC is disabled.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *VirtfusionServerVNCResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionServerVNCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionServerVNCResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vnc, err := r.setEnabled(data.ServerID.ValueInt64(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("VNC Update Failed", err.Error())
+		return
+	}
+
+	data.ID = data.ServerID
+	data.setConnection(vnc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerVNCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionServerVNCResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10) + "/vnc"
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data struct {
+			VNC apiVNC `json:"vnc"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.ID = data.ServerID
+	data.setFromAPI(respData.Data.VNC)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerVNCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionServerVNCResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vnc, err := r.setEnabled(data.ServerID.ValueInt64(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("VNC Update Failed", err.Error())
+		return
+	}
+
+	data.setConnection(vnc)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionServerVNCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionServerVNCResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.setEnabled(data.ServerID.ValueInt64(), false); err != nil {
+		resp.Diagnostics.AddError("VNC Update Failed", err.Error())
+		return
+	}
+}
+
+func (r *VirtfusionServerVNCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the server's numeric ID, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+}
+
+// setEnabled turns the server's VNC console on or off and returns its
+// resulting connection details.
+func (r *VirtfusionServerVNCResource) setEnabled(serverID int64, enabled bool) (apiVNC, error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"vnc": enabled,
+	})
+	reqURL := r.config.Endpoint + "/api/v1/servers/" + strconv.FormatInt(serverID, 10) + "/vnc"
+	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		return apiVNC{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return apiVNC{}, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		return apiVNC{}, fmt.Errorf("unexpected status %d while setting VNC for server %d", httpResp.StatusCode, serverID)
+	}
+
+	var respData struct {
+		Data struct {
+			VNC apiVNC `json:"vnc"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		return apiVNC{}, err
+	}
+	return respData.Data.VNC, nil
+}
+
+// setFromAPI copies the console's state into the model.
+func (m *VirtfusionServerVNCResourceModel) setFromAPI(vnc apiVNC) {
+	m.Enabled = types.BoolValue(vnc.Enabled)
+	m.setConnection(vnc)
+}
+
+// setConnection copies the connection details into the model while
+// m.Enabled is true and clears them otherwise. Create and Update keep the
+// planned enabled value rather than the response's, which may not reflect
+// the change yet.
+func (m *VirtfusionServerVNCResourceModel) setConnection(vnc apiVNC) {
+	m.Host = types.StringNull()
+	m.Port = types.Int64Null()
+	m.Password = types.StringNull()
+	if !m.Enabled.ValueBool() {
+		return
+	}
+
+	host := vnc.Hostname
+	if host == "" {
+		host = vnc.IP
+	}
+	m.Host = types.StringValue(host)
+	m.Port = types.Int64Value(vnc.Port)
+	m.Password = types.StringValue(vnc.Password)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionServersDataSource{}
+
+func NewVirtfusionServersDataSource() datasource.DataSource {
+	return &VirtfusionServersDataSource{}
+}
+
+type VirtfusionServersDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionServersDataSourceModel struct {
+	UserID            types.Int64                       `tfsdk:"user_id"`
+	HypervisorGroupID types.Int64                       `tfsdk:"hypervisor_group_id"`
+	Tag               types.String                      `tfsdk:"tag"`
+	NameRegex         types.String                      `tfsdk:"name_regex"`
+	PowerState        types.String                      `tfsdk:"power_state"`
+	Servers           []VirtfusionServerDataSourceModel `tfsdk:"servers"`
+}
+
+func (d *VirtfusionServersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_servers"
+}
+
+func (d *VirtfusionServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists servers, sorted by ID. Every filter that is set must match.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list servers owned by this user.",
+				Optional:            true,
+			},
+			"hypervisor_group_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list servers running on a hypervisor in this group.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Only list servers carrying this tag.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list servers whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "Only list servers in this power state, e.g. `running`.",
+				Optional:            true,
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching servers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionServersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionServersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	// Servers only know their hypervisor, so map hypervisors to their group
+	hypervisorGroup := map[int64]int64{}
+	if !data.HypervisorGroupID.IsNull() {
+		hypervisors, err := d.config.hypervisors()
+		if err != nil {
+			resp.Diagnostics.AddError("Hypervisor Lookup Failed", err.Error())
+			return
+		}
+		for _, hv := range hypervisors {
+			hypervisorGroup[hv.ID] = hv.Group.ID
+		}
+	}
+
+	servers, err := fetchServers(d.config, data.UserID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Server Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []apiServer
+	for _, s := range servers {
+		if !data.HypervisorGroupID.IsNull() && hypervisorGroup[s.HypervisorID] != data.HypervisorGroupID.ValueInt64() {
+			continue
+		}
+		if !data.Tag.IsNull() && !containsString(s.Tags, data.Tag.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(s.Name) {
+			continue
+		}
+		if !data.PowerState.IsNull() && s.RemoteState.State != data.PowerState.ValueString() {
+			continue
+		}
+		matches = append(matches, s)
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	data.Servers = []VirtfusionServerDataSourceModel{}
+	for _, s := range matches {
+		data.Servers = append(data.Servers, newServerDataSourceModel(s))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
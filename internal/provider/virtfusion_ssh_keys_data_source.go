@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionSSHKeysDataSource{}
+
+func NewVirtfusionSSHKeysDataSource() datasource.DataSource {
+	return &VirtfusionSSHKeysDataSource{}
+}
+
+type VirtfusionSSHKeysDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionSSHKeysDataSourceModel struct {
+	UserID    types.Int64   `tfsdk:"user_id"`
+	NameRegex types.String  `tfsdk:"name_regex"`
+	IDs       []types.Int64 `tfsdk:"ids"`
+	Keys      []sshKeyModel `tfsdk:"keys"`
+}
+
+type sshKeyModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	KeyType           types.String `tfsdk:"key_type"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *VirtfusionSSHKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_ssh_keys"
+}
+
+func (d *VirtfusionSSHKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists a user's SSH keys, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "User whose keys to list.",
+				Required:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list keys whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching keys, suitable for `ssh_keys` on `virtfusion_build`.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching SSH keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.Int64Attribute{Computed: true},
+						"name":               schema.StringAttribute{Computed: true},
+						"public_key":         schema.StringAttribute{Computed: true},
+						"fingerprint_sha256": schema.StringAttribute{Computed: true},
+						"key_type":           schema.StringAttribute{Computed: true},
+						"enabled":            schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtfusionSSHKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionSSHKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionSSHKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+
+	keys, err := fetchSSHKeys(d.config, data.UserID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("SSH Key Lookup Failed", err.Error())
+		return
+	}
+
+	var matches []apiSSHKey
+	for _, key := range keys {
+		if nameRegex == nil || nameRegex.MatchString(key.Name) {
+			matches = append(matches, key)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+
+	data.IDs = []types.Int64{}
+	data.Keys = []sshKeyModel{}
+	for _, key := range matches {
+		model := sshKeyModel{
+			ID:                types.Int64Value(key.ID),
+			Name:              types.StringValue(key.Name),
+			PublicKey:         types.StringValue(key.PublicKey),
+			FingerprintSHA256: types.StringNull(),
+			KeyType:           types.StringNull(),
+			Enabled:           types.BoolValue(key.Enabled),
+		}
+		if parsed, err := parseSSHPublicKey(key.PublicKey); err == nil {
+			model.FingerprintSHA256 = types.StringValue(ssh.FingerprintSHA256(parsed))
+			model.KeyType = types.StringValue(parsed.Type())
+		}
+
+		data.IDs = append(data.IDs, model.ID)
+		data.Keys = append(data.Keys, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
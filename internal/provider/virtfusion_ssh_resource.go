@@ -8,13 +8,19 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
 )
 
 // Ensure implementation
 var _ resource.Resource = &VirtfusionSSHResource{}
+var _ resource.ResourceWithModifyPlan = &VirtfusionSSHResource{}
 
 func NewVirtfusionSSHResource() resource.Resource {
 	return &VirtfusionSSHResource{}
@@ -26,10 +32,32 @@ type VirtfusionSSHResource struct {
 }
 
 type VirtfusionSSHResourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	UserID    types.Int64  `tfsdk:"user_id"`
-	Name      types.String `tfsdk:"name"`
-	PublicKey types.String `tfsdk:"public_key"`
+	ID                types.Int64       `tfsdk:"id"`
+	UserID            types.Int64       `tfsdk:"user_id"`
+	Name              types.String      `tfsdk:"name"`
+	PublicKey         sshPublicKeyValue `tfsdk:"public_key"`
+	FingerprintSHA256 types.String      `tfsdk:"fingerprint_sha256"`
+	KeyType           types.String      `tfsdk:"key_type"`
+}
+
+// apiSSHKey is an SSH key as returned by the VirtFusion API.
+type apiSSHKey struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+	Enabled   bool   `json:"enabled"`
+}
+
+// setKeyDetails derives the computed key attributes from public_key.
+func (m *VirtfusionSSHResourceModel) setKeyDetails() {
+	key, err := parseSSHPublicKey(m.PublicKey.ValueString())
+	if err != nil {
+		m.FingerprintSHA256 = types.StringNull()
+		m.KeyType = types.StringNull()
+		return
+	}
+	m.FingerprintSHA256 = types.StringValue(ssh.FingerprintSHA256(key))
+	m.KeyType = types.StringValue(key.Type())
 }
 
 func (r *VirtfusionSSHResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +69,9 @@ func (r *VirtfusionSSHResource) Schema(ctx context.Context, req resource.SchemaR
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"user_id": schema.Int64Attribute{
 				Required: true,
@@ -49,12 +80,40 @@ func (r *VirtfusionSSHResource) Schema(ctx context.Context, req resource.SchemaR
 				Required: true,
 			},
 			"public_key": schema.StringAttribute{
-				Required: true,
+				CustomType: sshPublicKeyType{},
+				Required:   true,
+				Validators: []validator.String{
+					sshPublicKeyValidator{},
+				},
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed: true,
+			},
+			"key_type": schema.StringAttribute{
+				Computed: true,
 			},
 		},
 	}
 }
 
+// ModifyPlan derives fingerprint_sha256 and key_type from the planned key, so
+// they are only shown as changing when the key material does.
+func (r *VirtfusionSSHResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data VirtfusionSSHResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.PublicKey.IsUnknown() {
+		return
+	}
+
+	data.setKeyDetails()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint_sha256"), data.FingerprintSHA256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key_type"), data.KeyType)...)
+}
+
 func (r *VirtfusionSSHResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -80,47 +139,14 @@ func (r *VirtfusionSSHResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	payload := map[string]interface{}{
-		"user_id":    data.UserID.ValueInt64(),
-		"name":       data.Name.ValueString(),
-		"public_key": data.PublicKey.ValueString(),
-	}
-
-	body, _ := json.Marshal(payload)
-	reqURL := r.config.Endpoint + "/api/v1/ssh-keys"
-
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	id, err := createSSHKey(r.config, data.UserID.ValueInt64(), data.Name.ValueString(), data.PublicKey.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+		resp.Diagnostics.AddError("SSH Key Creation Failed", err.Error())
 		return
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
 
-	httpResp, err := r.client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("API request failed", err.Error())
-		return
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Status: %d", httpResp.StatusCode),
-		)
-		return
-	}
-
-	var respData map[string]interface{}
-	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
-		resp.Diagnostics.AddError("Error decoding API response", err.Error())
-		return
-	}
-
-	if id, ok := respData["id"].(float64); ok {
-		data.ID = types.Int64Value(int64(id))
-	}
+	data.ID = types.Int64Value(id)
+	data.setKeyDetails()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -152,6 +178,24 @@ func (r *VirtfusionSSHResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	var respData struct {
+		Data apiSSHKey `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	// The API may normalize the key; semantic equality keeps the configured
+	// form in state as long as the key material is unchanged
+	if respData.Data.PublicKey != "" {
+		data.PublicKey = newSSHPublicKeyValue(respData.Data.PublicKey)
+	}
+	if respData.Data.Name != "" {
+		data.Name = types.StringValue(respData.Data.Name)
+	}
+	data.setKeyDetails()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -185,6 +229,7 @@ func (r *VirtfusionSSHResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	data.setKeyDetails()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -195,19 +240,68 @@ func (r *VirtfusionSSHResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	reqURL := r.config.Endpoint + "/api/v1/ssh-keys/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
-	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
-	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+	if err := deleteSSHKey(r.config, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("SSH Key Deletion Failed", err.Error())
+		return
+	}
+}
 
-	httpResp, err := r.client.Do(httpReq)
+// createSSHKey adds a public key to a user's account and returns its ID.
+func createSSHKey(config *ProviderConfig, userID int64, name, publicKey string) (int64, error) {
+	payload := map[string]interface{}{
+		"user_id":    userID,
+		"name":       name,
+		"public_key": publicKey,
+	}
+
+	body, _ := json.Marshal(payload)
+	httpReq, err := http.NewRequest("POST", config.Endpoint+"/api/v1/ssh-keys", bytes.NewBuffer(body))
 	if err != nil {
-		resp.Diagnostics.AddError("API request failed", err.Error())
-		return
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+config.ApiToken)
+
+	httpResp, err := config.Client.Do(httpReq)
+	if err != nil {
+		return 0, err
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
-		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
-		return
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		return 0, fmt.Errorf("unexpected status %d while creating SSH key %q", httpResp.StatusCode, name)
+	}
+
+	var respData struct {
+		Data apiSSHKey `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		return 0, err
+	}
+	if respData.Data.ID == 0 {
+		return 0, fmt.Errorf("no key ID in response while creating SSH key %q", name)
+	}
+	return respData.Data.ID, nil
+}
+
+// deleteSSHKey removes a key from its user's account. A key that no longer
+// exists is not an error.
+func deleteSSHKey(config *ProviderConfig, keyID int64) error {
+	reqURL := config.Endpoint + "/api/v1/ssh-keys/" + strconv.FormatInt(keyID, 10)
+	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+config.ApiToken)
+
+	httpResp, err := config.Client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		return fmt.Errorf("unexpected status %d while deleting SSH key %d", httpResp.StatusCode, keyID)
 	}
+	return nil
 }
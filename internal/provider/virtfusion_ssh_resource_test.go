@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSSHModifyPlan(t *testing.T) {
+	key, authorizedKey := newTestPublicKey(t)
+
+	tests := []struct {
+		name            string
+		publicKey       tftypes.Value
+		wantUnknown     bool
+		wantFingerprint string
+		wantType        string
+	}{
+		{
+			name:            "known key",
+			publicKey:       tftypes.NewValue(tftypes.String, authorizedKey+" user@host"),
+			wantFingerprint: ssh.FingerprintSHA256(key),
+			wantType:        ssh.KeyAlgoED25519,
+		},
+		{
+			name:        "unknown key",
+			publicKey:   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			wantUnknown: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			plan := newTestPlan(t, NewVirtfusionSSHResource(), map[string]tftypes.Value{
+				"public_key": tt.publicKey,
+			})
+			req := resource.ModifyPlanRequest{Plan: plan}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			NewVirtfusionSSHResource().(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var data VirtfusionSSHResourceModel
+			if diags := resp.Plan.Get(ctx, &data); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if tt.wantUnknown {
+				if !data.FingerprintSHA256.IsUnknown() || !data.KeyType.IsUnknown() {
+					t.Errorf("got %s and %s, want both unknown", data.FingerprintSHA256, data.KeyType)
+				}
+				return
+			}
+			if got := data.FingerprintSHA256.ValueString(); got != tt.wantFingerprint {
+				t.Errorf("fingerprint_sha256 = %q, want %q", got, tt.wantFingerprint)
+			}
+			if got := data.KeyType.ValueString(); got != tt.wantType {
+				t.Errorf("key_type = %q, want %q", got, tt.wantType)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionUserCreditResource{}
+var _ resource.ResourceWithImportState = &VirtfusionUserCreditResource{}
+
+func NewVirtfusionUserCreditResource() resource.Resource {
+	return &VirtfusionUserCreditResource{}
+}
+
+type VirtfusionUserCreditResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionUserCreditResourceModel struct {
+	ID            types.Int64   `tfsdk:"id"`
+	ExtRelationID types.Int64   `tfsdk:"ext_relation_id"`
+	Amount        types.Float64 `tfsdk:"amount"`
+	Reference     types.String  `tfsdk:"reference"`
+	Balance       types.Float64 `tfsdk:"balance"`
+	Usage         types.Float64 `tfsdk:"usage"`
+	SelfService   types.Int64   `tfsdk:"self_service"`
+	HourlyCredit  types.Bool    `tfsdk:"hourly_credit"`
+}
+
+// apiSelfServiceUsage is a user's self-service credit balance and the hourly
+// usage accrued in the current billing period.
+type apiSelfServiceUsage struct {
+	Credit struct {
+		Tokens float64 `json:"tokens"`
+	} `json:"credit"`
+	Hourly struct {
+		Tokens float64 `json:"tokens"`
+	} `json:"hourly"`
+}
+
+func (r *VirtfusionUserCreditResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_user_credit"
+}
+
+func (r *VirtfusionUserCreditResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants self-service credit to a user and reports the user's balance, usage and hourly billing settings. " +
+			"Hourly billing is read-only here because it belongs to the user; set it with `self_service` and `self_service_hourly_credit` on `virtfusion_user`. " +
+			"Destroying the resource removes the credit. An imported credit adopts the configured amount and reference.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Credit ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ext_relation_id": schema.Int64Attribute{
+				MarkdownDescription: "External relation ID of the user receiving the credit.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"amount": schema.Float64Attribute{
+				MarkdownDescription: "Amount of credit (tokens) to grant.",
+				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.Float64Request, resp *float64planmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !creditImported(ctx, req.State)
+						},
+						"Changing the amount grants a new credit; an imported credit adopts the configured amount.",
+						"Changing the amount grants a new credit; an imported credit adopts the configured amount.",
+					),
+				},
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: "Free-text reference shown with the credit, e.g. an invoice number.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !creditImported(ctx, req.State)
+						},
+						"Changing the reference grants a new credit; an imported credit adopts the configured reference.",
+						"Changing the reference grants a new credit; an imported credit adopts the configured reference.",
+					),
+				},
+			},
+			"balance": schema.Float64Attribute{
+				MarkdownDescription: "User's current credit balance.",
+				Computed:            true,
+			},
+			"usage": schema.Float64Attribute{
+				MarkdownDescription: "Hourly usage accrued by the user in the current billing period.",
+				Computed:            true,
+			},
+			"self_service": schema.Int64Attribute{
+				MarkdownDescription: "User's self-service mode: 0=Disabled, 1=Hourly, 2=Resource packs, 3=Both.",
+				Computed:            true,
+			},
+			"hourly_credit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user's hourly usage is paid from credit.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *VirtfusionUserCreditResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionUserCreditResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionUserCreditResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"tokens":      data.Amount.ValueFloat64(),
+		"reference_1": data.Reference.ValueString(),
+	}
+
+	body, _ := json.Marshal(payload)
+	reqURL := r.config.Endpoint + "/api/v1/selfService/credit/byUserExtRelationId/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10)
+
+	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Status: %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	var respData struct {
+		Data struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+	data.ID = types.Int64Value(respData.Data.ID)
+
+	if err := data.refresh(r.config); err != nil {
+		resp.Diagnostics.AddError("Error reading credit balance", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserCreditResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionUserCreditResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/selfService/usage/byUserExtRelationId/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// The credit goes away together with its user
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiSelfServiceUsage `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	user, err := fetchUserByExtRelation(r.config, data.ExtRelationID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading hourly billing settings", err.Error())
+		return
+	}
+	data.setFromAPI(respData.Data, user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserCreditResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Changing the amount or reference forces replacement, so this only runs
+	// to refresh the read-back values or to adopt the configuration of an
+	// imported credit
+	var data VirtfusionUserCreditResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.refresh(r.config); err != nil {
+		resp.Diagnostics.AddError("Error reading credit balance", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserCreditResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionUserCreditResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/selfService/credit/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+}
+
+func (r *VirtfusionUserCreditResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userPart, creditPart, ok := strings.Cut(req.ID, "/")
+	extRelationID, userErr := strconv.ParseInt(userPart, 10, 64)
+	creditID, creditErr := strconv.ParseInt(creditPart, 10, 64)
+	if !ok || userErr != nil || creditErr != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected <ext_relation_id>/<credit_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ext_relation_id"), extRelationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), creditID)...)
+}
+
+// refresh reads the user's current balance, usage and hourly billing settings
+// into the model.
+func (m *VirtfusionUserCreditResourceModel) refresh(config *ProviderConfig) error {
+	usage, err := fetchSelfServiceUsage(config, m.ExtRelationID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	user, err := fetchUserByExtRelation(config, m.ExtRelationID.ValueInt64())
+	if err != nil {
+		return err
+	}
+	m.setFromAPI(usage, user)
+	return nil
+}
+
+// setFromAPI copies the user's balance, usage and hourly billing settings into
+// the model.
+func (m *VirtfusionUserCreditResourceModel) setFromAPI(usage apiSelfServiceUsage, user apiUser) {
+	m.Balance = types.Float64Value(usage.Credit.Tokens)
+	m.Usage = types.Float64Value(usage.Hourly.Tokens)
+	m.SelfService = types.Int64Value(user.SelfService)
+	m.HourlyCredit = types.BoolValue(user.SelfServiceHourlyCredit)
+}
+
+// creditImported reports whether the credit in state was imported and not yet
+// applied. The API cannot read a credit back, so its amount is still null and
+// the configured amount and reference are adopted instead of forcing a new
+// credit.
+func creditImported(ctx context.Context, state tfsdk.State) bool {
+	var amount types.Float64
+	state.GetAttribute(ctx, path.Root("amount"), &amount)
+	return amount.IsNull()
+}
+
+// fetchSelfServiceUsage returns the user's current credit balance and usage.
+func fetchSelfServiceUsage(config *ProviderConfig, extRelationID int64) (apiSelfServiceUsage, error) {
+	var respData struct {
+		Data apiSelfServiceUsage `json:"data"`
+	}
+	apiPath := "/selfService/usage/byUserExtRelationId/" + strconv.FormatInt(extRelationID, 10)
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, apiPath, &respData); err != nil {
+		return apiSelfServiceUsage{}, err
+	}
+	return respData.Data, nil
+}
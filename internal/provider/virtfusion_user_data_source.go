@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ datasource.DataSource = &VirtfusionUserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &VirtfusionUserDataSource{}
+
+func NewVirtfusionUserDataSource() datasource.DataSource {
+	return &VirtfusionUserDataSource{}
+}
+
+type VirtfusionUserDataSource struct {
+	config *ProviderConfig
+}
+
+type VirtfusionUserDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ExtRelationID types.Int64  `tfsdk:"ext_relation_id"`
+	Email         types.String `tfsdk:"email"`
+	Name          types.String `tfsdk:"name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	SelfService   types.Int64  `tfsdk:"self_service"`
+}
+
+func (d *VirtfusionUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "virtfusion_user"
+}
+
+func (d *VirtfusionUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a panel user by ID, email or external relation ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "User ID. Exactly one of `id`, `email` or `ext_relation_id` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ext_relation_id": schema.Int64Attribute{
+				MarkdownDescription: "External relation ID. Exactly one of `id`, `email` or `ext_relation_id` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address, matched case-insensitively; a configured address is kept as written. Exactly one of `id`, `email` or `ext_relation_id` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Full name.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can log in.",
+				Computed:            true,
+			},
+			"self_service": schema.Int64Attribute{
+				MarkdownDescription: "Self-service mode: 0=Disabled, 1=Hourly, 2=Resource packs, 3=Both.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VirtfusionUserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("ext_relation_id"),
+		),
+	}
+}
+
+func (d *VirtfusionUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *VirtfusionUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtfusionUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user apiUser
+	if !data.ExtRelationID.IsNull() {
+		var err error
+		user, err = fetchUserByExtRelation(d.config, data.ExtRelationID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("User Lookup Failed", err.Error())
+			return
+		}
+	} else {
+		users, err := fetchUsers(d.config)
+		if err != nil {
+			resp.Diagnostics.AddError("User Lookup Failed", err.Error())
+			return
+		}
+
+		found := false
+		for _, u := range users {
+			if (!data.ID.IsNull() && u.ID == data.ID.ValueInt64()) ||
+				(!data.Email.IsNull() && strings.EqualFold(u.Email, data.Email.ValueString())) {
+				user = u
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("User Not Found", "No user matches the given ID or email.")
+			return
+		}
+	}
+
+	data.ID = types.Int64Value(user.ID)
+	data.ExtRelationID = types.Int64Value(user.ExtRelationID)
+	data.Email = stringOrDefault(data.Email, user.Email)
+	data.Name = types.StringValue(user.Name)
+	data.Enabled = types.BoolValue(user.Enabled)
+	data.SelfService = types.Int64Value(user.SelfService)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ ephemeral.EphemeralResource = &VirtfusionUserLoginTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &VirtfusionUserLoginTokenEphemeralResource{}
+
+func NewVirtfusionUserLoginTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &VirtfusionUserLoginTokenEphemeralResource{}
+}
+
+type VirtfusionUserLoginTokenEphemeralResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionUserLoginTokenEphemeralResourceModel struct {
+	ExtRelationID types.Int64  `tfsdk:"ext_relation_id"`
+	ServerID      types.Int64  `tfsdk:"server_id"`
+	Tokens        types.Map    `tfsdk:"tokens"`
+	LoginURL      types.String `tfsdk:"login_url"`
+}
+
+func (e *VirtfusionUserLoginTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "virtfusion_user_login_token"
+}
+
+func (e *VirtfusionUserLoginTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a one-time authentication token and login URL for a user. Neither is stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"ext_relation_id": schema.Int64Attribute{
+				MarkdownDescription: "External relation ID of the user to log in as.",
+				Required:            true,
+			},
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "Log the user straight into this server's page instead of the dashboard.",
+				Optional:            true,
+			},
+			"tokens": schema.MapAttribute{
+				MarkdownDescription: "Authentication tokens as returned by the panel.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"login_url": schema.StringAttribute{
+				MarkdownDescription: "Absolute one-click login URL on the panel.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *VirtfusionUserLoginTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = config.Client
+	e.config = config
+}
+
+func (e *VirtfusionUserLoginTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data VirtfusionUserLoginTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := e.config.Endpoint + "/api/v1/users/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10)
+	if data.ServerID.IsNull() {
+		reqURL += "/authenticationTokens"
+	} else {
+		reqURL += "/serverAuthenticationTokens/" + strconv.FormatInt(data.ServerID.ValueInt64(), 10)
+	}
+
+	httpReq, _ := http.NewRequest("POST", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+e.config.ApiToken)
+
+	httpResp, err := e.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data struct {
+			Authentication struct {
+				Tokens           map[string]string `json:"tokens"`
+				EndpointComplete string            `json:"endpoint_complete"`
+			} `json:"authentication"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	tokens, diags := types.MapValueFrom(ctx, types.StringType, respData.Data.Authentication.Tokens)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tokens = tokens
+	data.LoginURL = types.StringValue(panelURL(e.config.Endpoint, respData.Data.Authentication.EndpointComplete))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// panelURL turns a path on the panel, such as the login endpoint returned by
+// the API, into an absolute URL. URLs that are already absolute are returned
+// unchanged.
+func panelURL(endpoint, panelPath string) string {
+	if strings.HasPrefix(panelPath, "https://") || strings.HasPrefix(panelPath, "http://") {
+		return panelPath
+	}
+	if !strings.HasPrefix(panelPath, "/") {
+		panelPath = "/" + panelPath
+	}
+	return "https://" + endpoint + panelPath
+}
@@ -0,0 +1,23 @@
+package provider
+
+import "testing"
+
+func TestPanelURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		panelPath string
+		want      string
+	}{
+		{"absolute path", "/authenticate/abc", "https://panel.example.com/authenticate/abc"},
+		{"relative path", "authenticate/abc", "https://panel.example.com/authenticate/abc"},
+		{"already absolute", "https://other.example.com/authenticate/abc", "https://other.example.com/authenticate/abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := panelURL("panel.example.com", tt.panelPath); got != tt.want {
+				t.Errorf("panelURL(%q) = %q, want %q", tt.panelPath, got, tt.want)
+			}
+		})
+	}
+}
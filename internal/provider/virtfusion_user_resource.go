@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionUserResource{}
+var _ resource.ResourceWithImportState = &VirtfusionUserResource{}
+
+func NewVirtfusionUserResource() resource.Resource {
+	return &VirtfusionUserResource{}
+}
+
+type VirtfusionUserResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionUserResourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	ExtRelationID           types.Int64  `tfsdk:"ext_relation_id"`
+	Name                    types.String `tfsdk:"name"`
+	Email                   types.String `tfsdk:"email"`
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	SelfService             types.Int64  `tfsdk:"self_service"`
+	SelfServiceHourlyCredit types.Bool   `tfsdk:"self_service_hourly_credit"`
+	SendMail                types.Bool   `tfsdk:"send_mail"`
+	Password                types.String `tfsdk:"password"`
+}
+
+// apiUser is a panel user as returned by the VirtFusion API.
+type apiUser struct {
+	ID                      int64  `json:"id"`
+	ExtRelationID           int64  `json:"extRelationId"`
+	Name                    string `json:"name"`
+	Email                   string `json:"email"`
+	Enabled                 bool   `json:"enabled"`
+	SelfService             int64  `json:"selfService"`
+	SelfServiceHourlyCredit bool   `json:"selfServiceHourlyCredit"`
+	Password                string `json:"password"`
+}
+
+func (r *VirtfusionUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_user"
+}
+
+func (r *VirtfusionUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a panel user. Import using the external relation ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "User ID, as used by `user_id` on other resources.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ext_relation_id": schema.Int64Attribute{
+				MarkdownDescription: "External relation ID, usually the customer ID in a billing system. Must be unique.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Full name.",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address, also used to log in.",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can log in (default: true).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"self_service": schema.Int64Attribute{
+				MarkdownDescription: "Self-service mode: 0=Disabled, 1=Hourly, 2=Resource packs, 3=Both (default: 0).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3),
+				},
+			},
+			"self_service_hourly_credit": schema.BoolAttribute{
+				MarkdownDescription: "Whether hourly self-service usage is paid from credit (default: false).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"send_mail": schema.BoolAttribute{
+				MarkdownDescription: "Email the user their login details on creation (default: false).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password generated by the panel on creation. Not available for imported users.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"name":                    data.Name.ValueString(),
+		"email":                   data.Email.ValueString(),
+		"extRelationId":           data.ExtRelationID.ValueInt64(),
+		"selfService":             data.SelfService.ValueInt64(),
+		"selfServiceHourlyCredit": data.SelfServiceHourlyCredit.ValueBool(),
+		"sendMail":                data.SendMail.ValueBool(),
+	}
+
+	body, _ := json.Marshal(payload)
+	reqURL := r.config.Endpoint + "/api/v1/users"
+
+	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Status: %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	var respData struct {
+		Data apiUser `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(respData.Data.ID)
+	data.Password = types.StringValue(respData.Data.Password)
+
+	// New users are always enabled; disable afterwards if requested
+	if !data.Enabled.ValueBool() {
+		if err := r.setEnabled(data.ExtRelationID.ValueInt64(), false); err != nil {
+			resp.Diagnostics.AddError("Error disabling user", err.Error())
+			data.Enabled = types.BoolValue(true)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/users/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10) + "/byExtRelation"
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiUser `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(respData.Data.ID)
+	data.Name = types.StringValue(respData.Data.Name)
+	data.Email = types.StringValue(respData.Data.Email)
+	data.Enabled = types.BoolValue(respData.Data.Enabled)
+	data.SelfService = types.Int64Value(respData.Data.SelfService)
+	data.SelfServiceHourlyCredit = types.BoolValue(respData.Data.SelfServiceHourlyCredit)
+
+	// Only known for users created by this provider
+	if data.SendMail.IsNull() {
+		data.SendMail = types.BoolValue(false)
+	}
+	if data.Password.IsNull() {
+		data.Password = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VirtfusionUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/users/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10) + "/byExtRelation"
+	payload := map[string]interface{}{
+		"name":                    data.Name.ValueString(),
+		"email":                   data.Email.ValueString(),
+		"selfService":             data.SelfService.ValueInt64(),
+		"selfServiceHourlyCredit": data.SelfServiceHourlyCredit.ValueBool(),
+	}
+
+	body, _ := json.Marshal(payload)
+	httpReq, _ := http.NewRequest("PUT", reqURL, bytes.NewBuffer(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	if data.Enabled.ValueBool() != state.Enabled.ValueBool() {
+		if err := r.setEnabled(data.ExtRelationID.ValueInt64(), data.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error changing user status", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/users/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10) + "/byExtRelation"
+	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+}
+
+func (r *VirtfusionUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extRelationID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the user's numeric external relation ID, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ext_relation_id"), extRelationID)...)
+}
+
+// setEnabled enables or disables the user's panel login.
+func (r *VirtfusionUserResource) setEnabled(extRelationID int64, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/users/" + strconv.FormatInt(extRelationID, 10) + "/byExtRelation/" + action
+	httpReq, _ := http.NewRequest("POST", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		return fmt.Errorf("unexpected status %d while trying to %s user", httpResp.StatusCode, action)
+	}
+	return nil
+}
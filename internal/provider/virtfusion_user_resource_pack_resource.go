@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure implementation
+var _ resource.Resource = &VirtfusionUserResourcePackResource{}
+var _ resource.ResourceWithImportState = &VirtfusionUserResourcePackResource{}
+
+func NewVirtfusionUserResourcePackResource() resource.Resource {
+	return &VirtfusionUserResourcePackResource{}
+}
+
+type VirtfusionUserResourcePackResource struct {
+	client *http.Client
+	config *ProviderConfig
+}
+
+type VirtfusionUserResourcePackResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ExtRelationID types.Int64  `tfsdk:"ext_relation_id"`
+	PackID        types.Int64  `tfsdk:"pack_id"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Name          types.String `tfsdk:"name"`
+	ServerIDs     types.List   `tfsdk:"server_ids"`
+}
+
+// apiResourcePack is a resource pack assigned to a user.
+type apiResourcePack struct {
+	ID      int64  `json:"id"`
+	PackID  int64  `json:"packId"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	Servers []struct {
+		ID int64 `json:"id"`
+	} `json:"servers"`
+}
+
+func (r *VirtfusionUserResourcePackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "virtfusion_user_resource_pack"
+}
+
+func (r *VirtfusionUserResourcePackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a self-service resource pack to a user. Destroying the resource removes the pack from the user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user's resource pack.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ext_relation_id": schema.Int64Attribute{
+				MarkdownDescription: "External relation ID of the user receiving the pack.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"pack_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the resource pack to assign.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can deploy servers from the pack (default: true).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Resource pack name.",
+				Computed:            true,
+			},
+			"server_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the servers currently using the pack.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VirtfusionUserResourcePackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok || config == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = config.Client
+	r.config = config
+}
+
+func (r *VirtfusionUserResourcePackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtfusionUserResourcePackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"packId":  data.PackID.ValueInt64(),
+		"enabled": data.Enabled.ValueBool(),
+	}
+
+	// Packs are added through the user-scoped, singular endpoint; the pack
+	// that is created is then read, modified and deleted through
+	// selfService/resourcePacks/{id}, as documented by the VirtFusion API
+	body, _ := json.Marshal(payload)
+	reqURL := r.config.Endpoint + "/api/v1/selfService/resourcePack/byUserExtRelationId/" + strconv.FormatInt(data.ExtRelationID.ValueInt64(), 10)
+
+	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Status: %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	var respData struct {
+		Data apiResourcePack `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.setFromAPI(respData.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResourcePackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtfusionUserResourcePackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/selfService/resourcePacks/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("GET", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiResourcePack `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.setFromAPI(respData.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResourcePackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtfusionUserResourcePackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/selfService/resourcePacks/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	payload := map[string]interface{}{
+		"enabled": data.Enabled.ValueBool(),
+	}
+
+	body, _ := json.Marshal(payload)
+	httpReq, _ := http.NewRequest("PUT", reqURL, bytes.NewBuffer(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+
+	var respData struct {
+		Data apiResourcePack `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&respData); err != nil {
+		resp.Diagnostics.AddError("Error decoding API response", err.Error())
+		return
+	}
+
+	data.setFromAPI(respData.Data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtfusionUserResourcePackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtfusionUserResourcePackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqURL := r.config.Endpoint + "/api/v1/selfService/resourcePacks/" + strconv.FormatInt(data.ID.ValueInt64(), 10)
+	httpReq, _ := http.NewRequest("DELETE", reqURL, nil)
+	httpReq.Header.Set("Authorization", "Bearer "+r.config.ApiToken)
+
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Status: %d", httpResp.StatusCode))
+		return
+	}
+}
+
+func (r *VirtfusionUserResourcePackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userPart, packPart, ok := strings.Cut(req.ID, "/")
+	extRelationID, userErr := strconv.ParseInt(userPart, 10, 64)
+	id, packErr := strconv.ParseInt(packPart, 10, 64)
+	if !ok || userErr != nil || packErr != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected <ext_relation_id>/<id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ext_relation_id"), extRelationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setFromAPI copies the pack's current state into the model.
+func (m *VirtfusionUserResourcePackResourceModel) setFromAPI(pack apiResourcePack) {
+	m.ID = types.Int64Value(pack.ID)
+	m.PackID = types.Int64Value(pack.PackID)
+	m.Enabled = types.BoolValue(pack.Enabled)
+	m.Name = types.StringValue(pack.Name)
+	serverIDs := []attr.Value{}
+	for _, s := range pack.Servers {
+		serverIDs = append(serverIDs, types.Int64Value(s.ID))
+	}
+	m.ServerIDs = types.ListValueMust(types.Int64Type, serverIDs)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserResourcePackPlanGet(t *testing.T) {
+	tests := []struct {
+		name  string
+		known map[string]tftypes.Value
+	}{
+		{
+			name: "everything unknown",
+		},
+		{
+			name: "new pack",
+			known: map[string]tftypes.Value{
+				"ext_relation_id": tftypes.NewValue(tftypes.Number, 1001),
+				"pack_id":         tftypes.NewValue(tftypes.Number, 3),
+				"enabled":         tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := newTestPlan(t, NewVirtfusionUserResourcePackResource(), tt.known)
+			var data VirtfusionUserResourcePackResourceModel
+			if diags := plan.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("Plan.Get: %v", diags)
+			}
+		})
+	}
+}
+
+func TestUserResourcePackSetFromAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []int64
+	}{
+		{"no servers", `{"id": 9, "packId": 3, "servers": []}`, []int64{}},
+		{"servers missing", `{"id": 9, "packId": 3}`, []int64{}},
+		{"servers", `{"id": 9, "packId": 3, "servers": [{"id": 42}, {"id": 43}]}`, []int64{42, 43}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pack apiResourcePack
+			if err := json.Unmarshal([]byte(tt.body), &pack); err != nil {
+				t.Fatal(err)
+			}
+
+			var data VirtfusionUserResourcePackResourceModel
+			data.setFromAPI(pack)
+
+			var got []int64
+			if diags := data.ServerIDs.ElementsAs(context.Background(), &got, false); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("server_ids = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("server_ids = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// pollInterval is how often waitFor re-checks a long-running operation.
+var pollInterval = 10 * time.Second
+
+// waitFor calls check every pollInterval until it reports the operation as
+// done, returns an error, or ctx is cancelled or times out. Errors from check
+// are returned as soon as they occur; use stoppedWaiting to tell them apart
+// from the wait itself running out.
+func waitFor(ctx context.Context, check func() (bool, error)) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// stoppedWaiting reports whether err from waitFor means the wait ended, by
+// timeout or cancellation, before the operation did.
+func stoppedWaiting(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
+// apiTask is a queued panel task, such as a restore, as returned by the
+// VirtFusion API. Finished is empty while the task is still running.
+type apiTask struct {
+	ID       int64  `json:"id"`
+	Action   string `json:"action"`
+	Started  string `json:"started"`
+	Finished string `json:"finished"`
+	Failed   bool   `json:"failed"`
+}
+
+// fetchTask returns the current state of a queued task.
+func fetchTask(config *ProviderConfig, taskID int64) (apiTask, error) {
+	var respData struct {
+		Data apiTask `json:"data"`
+	}
+	if err := fetchJSON(config.Client, config.Endpoint, config.ApiToken, "/queue/"+strconv.FormatInt(taskID, 10), &respData); err != nil {
+		return apiTask{}, err
+	}
+	return respData.Data, nil
+}
+
+// waitForTask polls a queued task until it finishes and returns its final
+// state. A task that finished but failed is returned without an error.
+func waitForTask(ctx context.Context, config *ProviderConfig, taskID int64) (apiTask, error) {
+	var task apiTask
+	err := waitFor(ctx, func() (bool, error) {
+		current, err := fetchTask(config, taskID)
+		if err != nil {
+			return false, err
+		}
+		task = current
+		return task.Finished != "", nil
+	})
+	return task, err
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	errFetch := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		results     []error
+		doneAfter   int
+		timeout     time.Duration
+		wantCalls   int
+		wantErr     error
+		wantStopped bool
+	}{
+		{
+			name:      "done at once",
+			doneAfter: 1,
+			timeout:   time.Second,
+			wantCalls: 1,
+		},
+		{
+			name:      "done after polling",
+			doneAfter: 3,
+			timeout:   time.Second,
+			wantCalls: 3,
+		},
+		{
+			name:      "fetch error returned right away",
+			results:   []error{nil, errFetch},
+			doneAfter: 5,
+			timeout:   time.Second,
+			wantCalls: 2,
+			wantErr:   errFetch,
+		},
+		{
+			name:        "deadline",
+			doneAfter:   -1,
+			timeout:     5 * time.Millisecond,
+			wantErr:     context.DeadlineExceeded,
+			wantStopped: true,
+		},
+	}
+
+	interval := pollInterval
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = interval }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			calls := 0
+			err := waitFor(ctx, func() (bool, error) {
+				calls++
+				if calls <= len(tt.results) && tt.results[calls-1] != nil {
+					return false, tt.results[calls-1]
+				}
+				return calls == tt.doneAfter, nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := stoppedWaiting(err); got != tt.wantStopped {
+				t.Errorf("stoppedWaiting(%v) = %v, want %v", err, got, tt.wantStopped)
+			}
+			if tt.wantCalls > 0 && calls != tt.wantCalls {
+				t.Errorf("got %d checks, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}